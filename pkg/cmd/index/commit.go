@@ -0,0 +1,75 @@
+package index
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/index"
+	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
+	"github.com/spf13/cobra"
+)
+
+// Solr commit flags
+const (
+	commitModeFlag     = "commit-mode"
+	commitWithinFlag   = "commit-within"
+	expungeDeletesFlag = "expunge-deletes"
+	rollbackModeFlag   = "rollback-mode"
+	softCommitFlag     = "soft-commit"
+	waitSearcherFlag   = "wait-searcher"
+)
+
+var commitMode string          // when to issue explicit Solr commits
+var commitWithin time.Duration // ask Solr to commit each update within this long
+var expungeDeletes bool        // merge away deleted documents on commit
+var rollbackMode string        // how to undo the updates for a failed EAD file
+var softCommit bool            // issue soft commits instead of hard commits
+var waitSearcher bool          // block each commit until a new searcher is open
+
+func init() {
+	for _, cmd := range []*cobra.Command{IndexCmd, ServeCmd} {
+		addCommitFlags(cmd)
+	}
+
+	for _, cmd := range []*cobra.Command{DeleteCmd, IndexCmd, ServeCmd} {
+		cmd.Flags().StringVar(&rollbackMode, rollbackModeFlag, string(index.RollbackModeSolr),
+			fmt.Sprintf("how to undo the updates for a failed EAD file: %s (Solr <rollback/>) or %s "+
+				"(keep the documents of the previous run until the new ones are added, "+
+				"and delete those of the failed run)",
+				index.RollbackModeSolr, index.RollbackModeRunID))
+	}
+}
+
+func addCommitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&commitMode, commitModeFlag, string(index.CommitModeEachFile),
+		fmt.Sprintf("when to commit to Solr: %s, %s (once per git commit), or %s (rely on --%s or Solr autoCommit)",
+			index.CommitModeEachFile, index.CommitModeFinal, index.CommitModeNone, commitWithinFlag))
+	cmd.Flags().DurationVar(&commitWithin, commitWithinFlag, 0,
+		"ask Solr to commit each update within this long (0 means don't ask)")
+	cmd.Flags().BoolVar(&expungeDeletes, expungeDeletesFlag, solr.DefaultCommitOptions.ExpungeDeletes,
+		"merge away the segments' deleted documents on commit, which can be slow for large cores")
+	cmd.Flags().BoolVar(&softCommit, softCommitFlag, false,
+		"make commits soft commits, which are faster but rely on Solr autoCommit for durability")
+	cmd.Flags().BoolVar(&waitSearcher, waitSearcherFlag, solr.DefaultCommitOptions.WaitSearcher,
+		"wait for each commit's changes to be visible to searches before continuing")
+}
+
+// getCommitOptions returns the indexer commit mode and commit options set by
+// the commit flags.
+func getCommitOptions() (index.CommitMode, *solr.CommitOptions, error) {
+	mode := index.CommitModeEachFile
+	if commitMode != "" {
+		var err error
+		mode, err = index.ParseCommitMode(commitMode)
+		if err != nil {
+			return mode, nil, err
+		}
+	}
+
+	commitOptions := solr.DefaultCommitOptions
+	commitOptions.ExpungeDeletes = expungeDeletes
+	commitOptions.SoftCommit = softCommit
+	commitOptions.WaitSearcher = waitSearcher
+
+	return mode, &commitOptions, nil
+}
@@ -0,0 +1,78 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/index"
+	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
+)
+
+func TestGetCommitOptions(t *testing.T) {
+	restoreFlagVariablesAfterTest(t, &commitMode, &expungeDeletes, &softCommit, &waitSearcher)
+
+	testCases := []struct {
+		commitMode            string
+		expungeDeletes        bool
+		softCommit            bool
+		waitSearcher          bool
+		expectedCommitMode    index.CommitMode
+		expectedCommitOptions solr.CommitOptions
+		expectedError         string
+	}{
+		{
+			commitMode:            "",
+			waitSearcher:          true,
+			expectedCommitMode:    index.CommitModeEachFile,
+			expectedCommitOptions: solr.DefaultCommitOptions,
+		},
+		{
+			commitMode:         "final",
+			softCommit:         true,
+			waitSearcher:       true,
+			expectedCommitMode: index.CommitModeFinal,
+			expectedCommitOptions: solr.CommitOptions{
+				SoftCommit:   true,
+				WaitSearcher: true,
+			},
+		},
+		{
+			commitMode:         "each-file",
+			expungeDeletes:     true,
+			expectedCommitMode: index.CommitModeEachFile,
+			expectedCommitOptions: solr.CommitOptions{
+				ExpungeDeletes: true,
+				WaitSearcher:   false,
+			},
+		},
+		{
+			commitMode:    "sometimes",
+			expectedError: `unsupported commit mode "sometimes"; supported modes are: each-file, final, none`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		commitMode, expungeDeletes, softCommit, waitSearcher =
+			testCase.commitMode, testCase.expungeDeletes, testCase.softCommit, testCase.waitSearcher
+
+		actualCommitMode, actualCommitOptions, err := getCommitOptions()
+		if testCase.expectedError != "" {
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Errorf(`Expected error "%s", got "%v"`, testCase.expectedError, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("getCommitOptions() failed with error: %s", err)
+		}
+
+		if actualCommitMode != testCase.expectedCommitMode {
+			t.Errorf(`Expected commit mode "%s", got "%s"`, testCase.expectedCommitMode, actualCommitMode)
+		}
+
+		if *actualCommitOptions != testCase.expectedCommitOptions {
+			t.Errorf("Expected commit options %+v, got %+v", testCase.expectedCommitOptions, *actualCommitOptions)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package index
+
+import (
+	"fmt"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
+	"github.com/spf13/cobra"
+)
+
+// Date range facets flag
+const dateRangeFacetsFlag = "date-range-facets"
+
+var dateRangeFacetsPath string // JSON file of date range facet buckets
+
+func init() {
+	for _, cmd := range []*cobra.Command{IndexCmd, ServeCmd} {
+		cmd.Flags().StringVar(&dateRangeFacetsPath, dateRangeFacetsFlag, "",
+			fmt.Sprintf("JSON file of date range facet fields and buckets (default is centuries from 1101 through 2100 in %s)",
+				eadutil.DefaultDateRangeFacetFieldName))
+	}
+}
+
+// getDateRangeFacets returns the date range facets in the --date-range-facets
+// file, or nil for the defaults if there is none.
+func getDateRangeFacets() ([]eadutil.DateRangeFacet, error) {
+	if dateRangeFacetsPath == "" {
+		return nil, nil
+	}
+
+	return eadutil.LoadDateRangeFacets(dateRangeFacetsPath)
+}
@@ -0,0 +1,47 @@
+package index
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
+)
+
+func TestGetDateRangeFacets(t *testing.T) {
+	restoreFlagVariablesAfterTest(t, &dateRangeFacetsPath)
+
+	dateRangeFacetsPath = ""
+	dateRangeFacets, err := getDateRangeFacets()
+	if err != nil {
+		t.Fatalf("getDateRangeFacets() with no file failed with error: %s", err)
+	}
+	if dateRangeFacets != nil {
+		t.Errorf("Expected no date range facets with no file, got %v", dateRangeFacets)
+	}
+
+	dateRangeFacetsPath = filepath.Join(t.TempDir(), "date-range-facets.json")
+	err = os.WriteFile(dateRangeFacetsPath,
+		[]byte(`[{"field": "decade_sim", "interval": 10, "start": 1900, "end": 1919}]`), 0644)
+	if err != nil {
+		t.Fatalf("couldn't write date range facets file: %s", err)
+	}
+
+	dateRangeFacets, err = getDateRangeFacets()
+	if err != nil {
+		t.Fatalf("getDateRangeFacets() failed with error: %s", err)
+	}
+
+	expected := map[string][]string{"decade_sim": {"1910-1919"}}
+	actual := eadutil.GetDateRangeFacetValues([]string{"1915"}, dateRangeFacets)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected date range facet values %v, got %v", expected, actual)
+	}
+
+	dateRangeFacetsPath = filepath.Join(t.TempDir(), "nonexistent.json")
+	_, err = getDateRangeFacets()
+	if err == nil {
+		t.Errorf("Expected error for nonexistent date range facets file, got none")
+	}
+}
@@ -1,15 +1,23 @@
 package index
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
+	"net/http"
 	"os"
+	"os/signal"
+	"runtime/debug"
 	"slices"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead"
 	"github.com/nyulibraries/go-ead-indexer/pkg/index"
 	"github.com/nyulibraries/go-ead-indexer/pkg/log"
+	"github.com/nyulibraries/go-ead-indexer/pkg/metrics"
 	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
 	"github.com/spf13/cobra"
 )
@@ -18,11 +26,13 @@ import (
 const originEnvVar = "SOLR_ORIGIN_WITH_PORT"
 
 // error messages
+const eMsgCommitAndWatch = "the --commit and --watch arguments cannot be used together"
 const eMsgCommitOnlyWithGitRepo = "the --commit argument can only be used with the --git-repo argument"
 const eMsgCouldNotDetermineIndexingCase = "could not determine indexing case"
 const eMsgEADIDNotSet = "EADID is not set"
-const eMsgMissingCommitOrGitRepo = "missing argument: the --git-repo argument must be used with the --commit argument"
+const eMsgMissingCommitOrGitRepo = "missing argument: the --git-repo argument must be used with the --commit or --watch argument"
 const eMsgNeedOneButNotBothFileAndGitRepo = "one, but not both, of --file or --git-repo arguments must be specified"
+const eMsgWatchOnlyWithGitRepo = "the --watch argument can only be used with the --git-repo argument"
 
 const wMsgNoIndexerOperationsForGitCommit = "WARNING: there were no indexer operations to be carried out for git commit"
 
@@ -30,16 +40,30 @@ const wMsgNoIndexerOperationsForGitCommit = "WARNING: there were no indexer oper
 var localLogLevels = []string{"debug", "info", "error"}
 var localDefaultLogLevel = "info"
 
-var file string         // EAD file to be indexed
-var gitCommit string    // commit to index
-var gitRepoPath string  // path to EAD files git repo
-var eadID string        // EADID value of EAD data to delete
-var assumeYes bool      // flag to disable interactive mode
-var loggingLevel string // logging level
-var logger log.Logger   // logger
+var atomicReplace bool          // replace each EAD's data in a single Solr request
+var deleteStaleAfterAdd bool    // delete each EAD's stale data after adding the new data
+var file string                 // EAD file to be indexed
+var gitBranch string            // branch to watch
+var gitCommit string            // commit to index
+var gitRepoPath string          // path to EAD files git repo
+var watch bool                  // flag to enable watch mode
+var watchInterval time.Duration // how often to poll in watch mode
+var eadID string                // EADID value of EAD data to delete
+var assumeYes bool              // flag to disable interactive mode
+var loggingLevel string         // logging level
+var logger log.Logger           // logger
+var metricsAddress string       // address to serve metrics on
+var pushgatewayURL string       // Pushgateway to push metrics to at the end of the run
+var timeout time.Duration       // overall time limit for the run
+var indexer *index.Indexer      // indexer
 
 // This init() function contains a subset of the full 'index' command functionality
 func init() {
+	IndexCmd.Flags().BoolVar(&atomicReplace, "atomic-replace", false,
+		"send the delete and adds for each EAD file in a single Solr update request")
+	IndexCmd.Flags().BoolVar(&deleteStaleAfterAdd, "delete-stale-after-add", false,
+		"add and commit the new documents for each EAD file before deleting the old ones, so it stays searchable")
+	IndexCmd.MarkFlagsMutuallyExclusive("atomic-replace", "delete-stale-after-add")
 	IndexCmd.Flags().StringVarP(&gitCommit, "commit", "c",
 		"", "hash of git commit")
 	IndexCmd.Flags().StringVarP(&file, "file", "f", "",
@@ -49,6 +73,18 @@ func init() {
 	IndexCmd.Flags().StringVarP(&loggingLevel, "logging-level", "l",
 		localDefaultLogLevel,
 		"Sets logging level: "+strings.Join(localLogLevels, ", ")+"")
+	IndexCmd.Flags().BoolVarP(&watch, "watch", "w", false,
+		"poll the git repo remote and index new commits as they arrive")
+	IndexCmd.Flags().StringVarP(&gitBranch, "branch", "b",
+		index.DefaultWatchBranch, "branch to watch")
+	IndexCmd.Flags().DurationVarP(&watchInterval, "interval", "i",
+		index.DefaultWatchInterval, "how often to poll the git repo remote")
+	IndexCmd.Flags().StringVar(&metricsAddress, "metrics-address", "",
+		"serve Prometheus metrics on this address while indexing, e.g. \":9090\"")
+	IndexCmd.Flags().StringVar(&pushgatewayURL, "pushgateway-url", "",
+		"push Prometheus metrics to this Pushgateway URL when indexing is finished")
+	IndexCmd.Flags().DurationVarP(&timeout, "timeout", "t", 0,
+		"cancel and roll back indexing that is still running after this long (0 means no timeout)")
 
 	DeleteCmd.Flags().StringVarP(&eadID, "eadid", "e", "",
 		"EADID value of EAD data to delete")
@@ -57,6 +93,8 @@ func init() {
 	DeleteCmd.Flags().StringVarP(&loggingLevel, "logging-level", "l",
 		localDefaultLogLevel,
 		"Sets logging level: "+strings.Join(localLogLevels, ", ")+"")
+	DeleteCmd.Flags().DurationVarP(&timeout, "timeout", "t", 0,
+		"cancel and roll back the delete if it is still running after this long (0 means no timeout)")
 }
 
 var DeleteCmd = &cobra.Command{
@@ -71,7 +109,9 @@ var IndexCmd = &cobra.Command{
 	Use:   "index",
 	Short: "Index EAD file or commit",
 	Example: `  go-ead-indexer index --file=[path to EAD file] --logging-level="debug"
-  go-ead-indexer index --git-repo=[path] --commit=[hash] --logging-level="error"`,
+  go-ead-indexer index --git-repo=[path] --commit=[hash] --logging-level="error"
+  go-ead-indexer index --git-repo=[path] --watch --branch=main --interval=5m --metrics-address=":9090"
+  go-ead-indexer index --git-repo=[path] --commit=[hash] --pushgateway-url=http://localhost:9091`,
 	Args: indexCheckArgs,
 	RunE: runIndexCmd,
 }
@@ -85,6 +125,10 @@ func isIndexGitCommitCase() bool {
 	return (gitRepoPath != "" && gitCommit != "")
 }
 
+func isIndexGitWatchCase() bool {
+	return (gitRepoPath != "" && watch)
+}
+
 // runDeleteCmd is the main function for the 'delete' verb
 // It initializes the logger and Solr client, then deletes the data by EADID
 // It exits with a fatal error if any of these steps fail
@@ -130,14 +174,17 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	// initialize Solr client
-	err = initSolrClient()
+	err = initSolrClient(cmd)
 	if err != nil {
 		emsg := fmt.Sprintf("couldn't initialize Solr client: %s", err)
 		return logAndReturnError(emsg)
 	}
 
+	ctx, cancel := newRunContext()
+	defer cancel()
+
 	// delete data associated with EADID
-	err = index.DeleteEADFileDataFromIndex(eadID)
+	err = indexer.DeleteEADFileDataFromIndex(ctx, eadID)
 	if err != nil {
 		emsg := fmt.Sprintf("couldn't delete data for EADID: %s %s", eadID, err)
 		return logAndReturnError(emsg)
@@ -169,17 +216,30 @@ func runIndexCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	// initialize Solr client
-	err = initSolrClient()
+	err = initSolrClient(cmd)
 	if err != nil {
 		emsg := fmt.Sprintf("couldn't initialize Solr client: %s", err)
 		return logAndReturnError(emsg)
 	}
 
+	if metricsAddress != "" {
+		serveMetrics(metricsAddress)
+	}
+
+	if pushgatewayURL != "" {
+		defer pushMetrics(pushgatewayURL)
+	}
+
+	ctx, cancel := newRunContext()
+	defer cancel()
+
 	switch {
 	case isIndexEADCase():
-		return runIndexEAD()
+		return runIndexEAD(ctx)
 	case isIndexGitCommitCase():
-		return runIndexGitCommit()
+		return runIndexGitCommit(ctx)
+	case isIndexGitWatchCase():
+		return runIndexGitWatch(ctx)
 	default:
 		emsg := eMsgCouldNotDetermineIndexingCase
 		return logAndReturnError(emsg)
@@ -187,7 +247,7 @@ func runIndexCmd(cmd *cobra.Command, args []string) error {
 }
 
 // runIndexEAD is the main function for the 'index EAD' case
-func runIndexEAD() error {
+func runIndexEAD(ctx context.Context) error {
 
 	// check that the EAD file exists
 	if _, err := os.Stat(file); errors.Is(err, fs.ErrNotExist) {
@@ -196,7 +256,7 @@ func runIndexEAD() error {
 	}
 
 	// index EAD file
-	err := index.IndexEADFile(file)
+	err := indexer.IndexEADFile(ctx, file)
 	if err != nil {
 		emsg := fmt.Sprintf("couldn't index EAD file: %s", err)
 		return logAndReturnError(emsg)
@@ -207,9 +267,9 @@ func runIndexEAD() error {
 	return nil
 }
 
-func runIndexGitCommit() error {
+func runIndexGitCommit(ctx context.Context) error {
 	// index Git Commit
-	numIndexerOperations, err := index.IndexGitCommit(gitRepoPath, gitCommit)
+	numIndexerOperations, err := indexer.IndexGitCommit(ctx, gitRepoPath, gitCommit)
 	if err != nil {
 		emsg := fmt.Sprintf("problem indexing git commit %s: %s", gitCommit, err)
 		return logAndReturnError(emsg)
@@ -229,6 +289,23 @@ func runIndexGitCommit() error {
 	}
 }
 
+// runIndexGitWatch is the main function for the 'watch git repo' case
+// It runs until `ctx` is done.  A commit that is being indexed when `ctx` is
+// done is rolled back.
+func runIndexGitWatch(ctx context.Context) error {
+	err := indexer.WatchGitRepo(ctx, gitRepoPath, index.WatchOptions{
+		Branch:   gitBranch,
+		Interval: watchInterval,
+	})
+	if err != nil {
+		emsg := fmt.Sprintf("problem watching git repo %s: %s", gitRepoPath, err)
+		return logAndReturnError(emsg)
+	}
+
+	logger.Info(index.MessageKey, fmt.Sprintf("SUCCESS: stopped watching git repo: %s", gitRepoPath))
+	return nil
+}
+
 // initLogger initializes the logger in the pkg/cmd/index package
 func initLogger() error {
 
@@ -251,33 +328,123 @@ func initLogger() error {
 
 	logger.Info(index.MessageKey, fmt.Sprintf("Logging level set to \"%s\"", normalizedLogLevel))
 
-	// initialize logger in the pkg/index package
-	err = index.InitLogger(logger)
-	if err != nil {
-		emsg := fmt.Sprintf("couldn't initialize logger: %s", err)
-		return logAndReturnError(emsg)
-	}
-
 	return nil
 }
 
-// initSolrClient initializes the Solr client in the pkg/index package
-func initSolrClient() error {
+// initSolrClient initializes the Solr client and the indexer that uses it.
+// `initLogger()` must be called first.
+func initSolrClient(cmd *cobra.Command) error {
 	solrOrigin := os.Getenv(originEnvVar)
 	if solrOrigin == "" {
 		return fmt.Errorf("'%s' environment variable not set", originEnvVar)
 	}
 
-	sc, err := solr.NewSolrClient(solrOrigin)
+	retryPolicy, err := getRetryPolicy(cmd)
+	if err != nil {
+		return fmt.Errorf("error creating Solr client: %s", err)
+	}
+
+	mode, commitOptions, err := getCommitOptions()
+	if err != nil {
+		return fmt.Errorf("error creating indexer: %s", err)
+	}
+
+	parsedRollbackMode, err := index.ParseRollbackMode(rollbackMode)
+	if err != nil {
+		return fmt.Errorf("error creating indexer: %s", err)
+	}
+
+	dateRangeFacets, err := getDateRangeFacets()
+	if err != nil {
+		return fmt.Errorf("error creating indexer: couldn't load date range facets: %s", err)
+	}
+
+	parsedLanguageMode, err := getLanguageMode()
+	if err != nil {
+		return fmt.Errorf("error creating indexer: %s", err)
+	}
+
+	sc, err := solr.NewSolrClientWithOptions(solrOrigin, solr.Options{
+		CommitWithin: commitWithin,
+		RetryPolicy:  &retryPolicy,
+		UpdateFormat: getUpdateFormat(cmd),
+	})
 	if err != nil {
 		return fmt.Errorf("error creating Solr client: %s", err)
 	}
 
-	index.SetSolrClient(sc)
+	indexer = index.NewIndexer(sc, logger, index.Options{
+		AtomicReplace:       atomicReplace,
+		CommitMode:          mode,
+		CommitOptions:       commitOptions,
+		DeleteStaleAfterAdd: deleteStaleAfterAdd,
+		EADOptions: ead.Options{
+			DateRangeFacets: dateRangeFacets,
+			LanguageMode:    parsedLanguageMode,
+		},
+		IndexerVersion: getIndexerVersion(),
+		RollbackMode:   parsedRollbackMode,
+	})
 
 	return nil
 }
 
+// getIndexerVersion returns the version of this program that the Solr documents
+// are tagged with.  For a build from a git checkout, this is a pseudo-version
+// that includes the revision.  It is empty if the version is unknown.
+func getIndexerVersion() string {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok || buildInfo.Main.Version == "(devel)" {
+		return ""
+	}
+
+	return buildInfo.Main.Version
+}
+
+// newRunContext returns a context that is canceled when the process receives
+// SIGINT or SIGTERM, or when the --timeout has passed.
+func newRunContext() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	if timeout <= 0 {
+		return ctx, stop
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// pushMetrics pushes the metrics for this run to the Pushgateway.  Failure to
+// push is logged but is not an indexing error.
+func pushMetrics(url string) {
+	err := metrics.Push(url, metrics.DefaultJobName)
+	if err != nil {
+		logger.Error(index.MessageKey, fmt.Sprintf("couldn't push metrics to %s: %s", url, err))
+		return
+	}
+
+	logger.Info(index.MessageKey, fmt.Sprintf("pushed metrics to %s", url))
+}
+
+// serveMetrics serves the metrics in the background for as long as the
+// process is running.  Failure to serve is logged but is not an indexing
+// error.
+func serveMetrics(address string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	go func() {
+		logger.Info(index.MessageKey, fmt.Sprintf("serving metrics on %s", address))
+		err := http.ListenAndServe(address, mux)
+		if err != nil {
+			logger.Error(index.MessageKey, fmt.Sprintf("couldn't serve metrics on %s: %s", address, err))
+		}
+	}()
+}
+
 func logAndReturnError(emsg string) error {
 	logger.Error(index.MessageKey, emsg)
 	return fmt.Errorf("%s", emsg)
@@ -317,7 +484,15 @@ func indexCheckArgs(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("%s", eMsgCommitOnlyWithGitRepo)
 	}
 
-	if (gitRepoPath != "" && gitCommit == "") ||
+	if file != "" && watch {
+		return fmt.Errorf("%s", eMsgWatchOnlyWithGitRepo)
+	}
+
+	if gitCommit != "" && watch {
+		return fmt.Errorf("%s", eMsgCommitAndWatch)
+	}
+
+	if (gitRepoPath != "" && gitCommit == "" && !watch) ||
 		(gitRepoPath == "" && gitCommit != "") {
 		return fmt.Errorf("%s", eMsgMissingCommitOrGitRepo)
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"slices"
 	"testing"
@@ -207,6 +208,7 @@ func TestIndex_ArgumentValidation(t *testing.T) {
 	fileFlag := "file"
 	gitRepoFlag := "git-repo"
 	gitCommitFlag := "commit"
+	watchFlag := "watch"
 
 	// set up arguments
 	dir, err := testutils.GetCallingFileDirPath()
@@ -222,16 +224,21 @@ func TestIndex_ArgumentValidation(t *testing.T) {
 		File        string
 		GitRepoPath string
 		GitCommit   string
+		Watch       string
 		Want        string
 	}{
-		{"", "", "", eMsgNeedOneButNotBothFileAndGitRepo},                   // fail: neither the file nor the git-repo flag is set
-		{"", "", gitCommit, eMsgNeedOneButNotBothFileAndGitRepo},            // fail: only the commit flag is set
-		{"", gitRepoPath, "", eMsgMissingCommitOrGitRepo},                   // fail: only the git-repo flag is set
-		{"", gitRepoPath, gitCommit, ""},                                    // pass: the git-repo and commit flags are set
-		{file, "", "", ""},                                                  // pass: only the file flag is set
-		{file, "", gitCommit, eMsgCommitOnlyWithGitRepo},                    // fail: both file and commit flags are set
-		{file, gitRepoPath, "", eMsgNeedOneButNotBothFileAndGitRepo},        // fail: both file and git-repo flags are set
-		{file, gitRepoPath, gitCommit, eMsgNeedOneButNotBothFileAndGitRepo}, // fail: all three flags are set
+		{"", "", "", "false", eMsgNeedOneButNotBothFileAndGitRepo},                   // fail: neither the file nor the git-repo flag is set
+		{"", "", gitCommit, "false", eMsgNeedOneButNotBothFileAndGitRepo},            // fail: only the commit flag is set
+		{"", gitRepoPath, "", "false", eMsgMissingCommitOrGitRepo},                   // fail: only the git-repo flag is set
+		{"", gitRepoPath, gitCommit, "false", ""},                                    // pass: the git-repo and commit flags are set
+		{file, "", "", "false", ""},                                                  // pass: only the file flag is set
+		{file, "", gitCommit, "false", eMsgCommitOnlyWithGitRepo},                    // fail: both file and commit flags are set
+		{file, gitRepoPath, "", "false", eMsgNeedOneButNotBothFileAndGitRepo},        // fail: both file and git-repo flags are set
+		{file, gitRepoPath, gitCommit, "false", eMsgNeedOneButNotBothFileAndGitRepo}, // fail: all three flags are set
+		{"", "", "", "true", eMsgNeedOneButNotBothFileAndGitRepo},                    // fail: only the watch flag is set
+		{"", gitRepoPath, "", "true", ""},                                            // pass: the git-repo and watch flags are set
+		{"", gitRepoPath, gitCommit, "true", eMsgCommitAndWatch},                     // fail: the git-repo, commit, and watch flags are set
+		{file, "", "", "true", eMsgWatchOnlyWithGitRepo},                             // fail: both file and watch flags are set
 	}
 
 	for _, scenario := range scenarios {
@@ -239,6 +246,7 @@ func TestIndex_ArgumentValidation(t *testing.T) {
 		testutils.SetCmdFlag(IndexCmd, fileFlag, scenario.File)
 		testutils.SetCmdFlag(IndexCmd, gitRepoFlag, scenario.GitRepoPath)
 		testutils.SetCmdFlag(IndexCmd, gitCommitFlag, scenario.GitCommit)
+		testutils.SetCmdFlag(IndexCmd, watchFlag, scenario.Watch)
 
 		want = scenario.Want
 		got = indexCheckArgs(IndexCmd, args)
@@ -599,10 +607,30 @@ func resetDeleteArgs() {
 	cmd.Flags().Set("logging-level", "")
 }
 
+// restoreFlagVariablesAfterTest puts back the current values of the flag
+// variables that `flagVariables` point to when `t` ends.  The flags of every
+// command share the same variables, so tests mustn't leave values set in them
+// for the other tests.
+func restoreFlagVariablesAfterTest(t *testing.T, flagVariables ...any) {
+	savedValues := make([]reflect.Value, len(flagVariables))
+	for i, flagVariable := range flagVariables {
+		value := reflect.ValueOf(flagVariable).Elem()
+		savedValues[i] = reflect.New(value.Type()).Elem()
+		savedValues[i].Set(value)
+	}
+
+	t.Cleanup(func() {
+		for i, flagVariable := range flagVariables {
+			reflect.ValueOf(flagVariable).Elem().Set(savedValues[i])
+		}
+	})
+}
+
 func resetIndexArgs() {
 	cmd := IndexCmd
 	cmd.Flags().Set("file", "")
 	cmd.Flags().Set("git-repo", "")
 	cmd.Flags().Set("commit", "")
 	cmd.Flags().Set("logging-level", "")
+	cmd.Flags().Set("watch", "false")
 }
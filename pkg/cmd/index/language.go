@@ -0,0 +1,27 @@
+package index
+
+import (
+	"fmt"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/language"
+	"github.com/spf13/cobra"
+)
+
+// Language mode flag
+const languageModeFlag = "language-mode"
+
+var languageMode string // language code tables to use, see `language.Mode`
+
+func init() {
+	for _, cmd := range []*cobra.Command{IndexCmd, ServeCmd} {
+		cmd.Flags().StringVar(&languageMode, languageModeFlag, string(language.ModeV1),
+			fmt.Sprintf("language code lookup mode: %q replicates v1 indexer translations, %q uses current ISO 639-2/639-3 and accepts BCP 47 tags",
+				language.ModeV1, language.ModeModern))
+	}
+}
+
+// getLanguageMode returns the language code lookup mode set by the
+// --language-mode flag.
+func getLanguageMode() (language.Mode, error) {
+	return language.ParseMode(languageMode)
+}
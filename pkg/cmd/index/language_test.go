@@ -0,0 +1,27 @@
+package index
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/language"
+)
+
+func TestGetLanguageMode(t *testing.T) {
+	restoreFlagVariablesAfterTest(t, &languageMode)
+
+	languageMode = string(language.ModeModern)
+	mode, err := getLanguageMode()
+	if err != nil {
+		t.Fatalf("getLanguageMode() failed with error: %s", err)
+	}
+	if mode != language.ModeModern {
+		t.Errorf("Expected language mode %q, got %q", language.ModeModern, mode)
+	}
+
+	languageMode = "v3"
+	_, err = getLanguageMode()
+	if !errors.Is(err, language.ErrInvalidMode) {
+		t.Errorf("Expected error %q for invalid language mode, got %v", language.ErrInvalidMode, err)
+	}
+}
@@ -0,0 +1,106 @@
+package index
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
+	"github.com/spf13/cobra"
+)
+
+// Solr retry policy flags, and the environment variables that are used when
+// a flag is not set on the command line.
+const (
+	retryBaseFlag        = "solr-retry-base"
+	retryCapFlag         = "solr-retry-cap"
+	retryJitterFlag      = "solr-retry-jitter"
+	retryMaxAttemptsFlag = "solr-max-attempts"
+
+	retryBaseEnvVar        = "SOLR_RETRY_BASE"
+	retryCapEnvVar         = "SOLR_RETRY_CAP"
+	retryJitterEnvVar      = "SOLR_RETRY_JITTER"
+	retryMaxAttemptsEnvVar = "SOLR_MAX_ATTEMPTS"
+)
+
+var retryBase time.Duration // base wait between Solr request retries
+var retryCap time.Duration  // maximum wait between Solr request retries
+var retryJitter bool        // randomize waits between Solr request retries
+var retryMaxAttempts int    // total tries for each Solr request
+
+func init() {
+	for _, cmd := range []*cobra.Command{DeleteCmd, IndexCmd, ServeCmd} {
+		addRetryPolicyFlags(cmd)
+	}
+}
+
+func addRetryPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&retryBase, retryBaseFlag,
+		solr.DefaultRetryPolicy.BaseInterval,
+		"wait before the first retry of a failed Solr request, multiplied for each later retry [$"+retryBaseEnvVar+"]")
+	cmd.Flags().DurationVar(&retryCap, retryCapFlag,
+		solr.DefaultRetryPolicy.MaxInterval,
+		"maximum wait between retries of a failed Solr request, 0 for no maximum [$"+retryCapEnvVar+"]")
+	cmd.Flags().BoolVar(&retryJitter, retryJitterFlag,
+		solr.DefaultRetryPolicy.Jitter,
+		"wait a random time of up to the computed wait between retries [$"+retryJitterEnvVar+"]")
+	cmd.Flags().IntVar(&retryMaxAttempts, retryMaxAttemptsFlag,
+		solr.DefaultRetryPolicy.MaxAttempts,
+		fmt.Sprintf("total number of tries for each Solr request, including the first, up to %d [$%s]",
+			solr.MaxAttemptsLimit, retryMaxAttemptsEnvVar))
+}
+
+// getRetryPolicy returns the Solr retry policy set by the flags of `cmd`.  For
+// each flag not set on the command line, the environment variable is used if
+// it is set, otherwise the default.
+func getRetryPolicy(cmd *cobra.Command) (solr.RetryPolicy, error) {
+	retryPolicy := solr.RetryPolicy{
+		BaseInterval: retryBase,
+		Jitter:       retryJitter,
+		MaxAttempts:  retryMaxAttempts,
+		MaxInterval:  retryCap,
+	}
+
+	var err error
+
+	if value, ok := getEnvForUnsetFlag(cmd, retryBaseFlag, retryBaseEnvVar); ok {
+		retryPolicy.BaseInterval, err = time.ParseDuration(value)
+		if err != nil {
+			return retryPolicy, fmt.Errorf("invalid %s: %s", retryBaseEnvVar, err)
+		}
+	}
+
+	if value, ok := getEnvForUnsetFlag(cmd, retryCapFlag, retryCapEnvVar); ok {
+		retryPolicy.MaxInterval, err = time.ParseDuration(value)
+		if err != nil {
+			return retryPolicy, fmt.Errorf("invalid %s: %s", retryCapEnvVar, err)
+		}
+	}
+
+	if value, ok := getEnvForUnsetFlag(cmd, retryJitterFlag, retryJitterEnvVar); ok {
+		retryPolicy.Jitter, err = strconv.ParseBool(value)
+		if err != nil {
+			return retryPolicy, fmt.Errorf("invalid %s: %s", retryJitterEnvVar, err)
+		}
+	}
+
+	if value, ok := getEnvForUnsetFlag(cmd, retryMaxAttemptsFlag, retryMaxAttemptsEnvVar); ok {
+		retryPolicy.MaxAttempts, err = strconv.Atoi(value)
+		if err != nil {
+			return retryPolicy, fmt.Errorf("invalid %s: %s", retryMaxAttemptsEnvVar, err)
+		}
+	}
+
+	return retryPolicy, retryPolicy.Validate()
+}
+
+func getEnvForUnsetFlag(cmd *cobra.Command, flag string, envVar string) (string, bool) {
+	if cmd.Flags().Changed(flag) {
+		return "", false
+	}
+
+	value := os.Getenv(envVar)
+
+	return value, value != ""
+}
@@ -0,0 +1,103 @@
+package index
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
+	"github.com/spf13/cobra"
+)
+
+func TestGetRetryPolicy(t *testing.T) {
+	restoreFlagVariablesAfterTest(t, &retryBase, &retryCap, &retryJitter, &retryMaxAttempts)
+
+	testCases := []struct {
+		name          string
+		envVars       map[string]string
+		flags         map[string]string
+		expected      solr.RetryPolicy
+		expectedError string
+	}{
+		{
+			name:     "Defaults",
+			expected: solr.DefaultRetryPolicy,
+		},
+		{
+			name: "Environment variables",
+			envVars: map[string]string{
+				retryBaseEnvVar:        "250ms",
+				retryCapEnvVar:         "10s",
+				retryJitterEnvVar:      "false",
+				retryMaxAttemptsEnvVar: "6",
+			},
+			expected: solr.RetryPolicy{
+				BaseInterval: 250 * time.Millisecond,
+				Jitter:       false,
+				MaxAttempts:  6,
+				MaxInterval:  10 * time.Second,
+			},
+		},
+		{
+			name: "Flags take precedence over environment variables",
+			envVars: map[string]string{
+				retryBaseEnvVar:        "250ms",
+				retryMaxAttemptsEnvVar: "6",
+			},
+			flags: map[string]string{
+				retryBaseFlag:        "2s",
+				retryMaxAttemptsFlag: "1",
+			},
+			expected: solr.RetryPolicy{
+				BaseInterval: 2 * time.Second,
+				Jitter:       solr.DefaultRetryPolicy.Jitter,
+				MaxAttempts:  1,
+				MaxInterval:  solr.DefaultRetryPolicy.MaxInterval,
+			},
+		},
+		{
+			name:          "Invalid environment variable",
+			envVars:       map[string]string{retryCapEnvVar: "a while"},
+			expectedError: `invalid SOLR_RETRY_CAP: time: invalid duration "a while"`,
+		},
+		{
+			name:          "Invalid policy",
+			flags:         map[string]string{retryMaxAttemptsFlag: "0"},
+			expectedError: "retry policy: max attempts must be at least 1, got 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, envVar := range []string{retryBaseEnvVar, retryCapEnvVar,
+				retryJitterEnvVar, retryMaxAttemptsEnvVar} {
+				t.Setenv(envVar, testCase.envVars[envVar])
+			}
+
+			cmd := &cobra.Command{}
+			addRetryPolicyFlags(cmd)
+			for flag, value := range testCase.flags {
+				err := cmd.Flags().Set(flag, value)
+				if err != nil {
+					t.Fatalf("couldn't set --%s: %s", flag, err)
+				}
+			}
+
+			actual, err := getRetryPolicy(cmd)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Errorf(`Expected error "%s", got "%v"`, testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("getRetryPolicy() failed with error: %s", err)
+			}
+
+			if actual != testCase.expected {
+				t.Errorf("Expected retry policy %+v, got %+v", testCase.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/index"
+	"github.com/nyulibraries/go-ead-indexer/pkg/server"
+	"github.com/spf13/cobra"
+)
+
+const eMsgGitRepoNotSet = "missing argument: the --git-repo argument must be specified"
+
+// Webhook secret flag, and the environment variable that is used when the flag
+// is not set on the command line.  The environment variable keeps the secret
+// out of process listings.
+const (
+	webhookSecretFlag   = "webhook-secret"
+	webhookSecretEnvVar = "WEBHOOK_SECRET"
+)
+
+var serveAddress string  // address for the HTTP server to listen on
+var webhookSecret string // secret that webhook requests must be signed with
+
+func init() {
+	ServeCmd.Flags().StringVarP(&serveAddress, "address", "a",
+		server.DefaultAddress, "address for the HTTP server to listen on")
+	ServeCmd.Flags().BoolVar(&atomicReplace, "atomic-replace", false,
+		"send the delete and adds for each EAD file in a single Solr update request")
+	ServeCmd.Flags().BoolVar(&deleteStaleAfterAdd, "delete-stale-after-add", false,
+		"add and commit the new documents for each EAD file before deleting the old ones, so it stays searchable")
+	ServeCmd.MarkFlagsMutuallyExclusive("atomic-replace", "delete-stale-after-add")
+	ServeCmd.Flags().StringVarP(&gitBranch, "branch", "b",
+		index.DefaultWatchBranch, "only index pushes to this branch")
+	ServeCmd.Flags().StringVarP(&gitRepoPath, "git-repo", "g", "",
+		"path to EAD files git repo")
+	ServeCmd.Flags().StringVarP(&loggingLevel, "logging-level", "l",
+		localDefaultLogLevel,
+		"Sets logging level: "+strings.Join(localLogLevels, ", ")+"")
+	ServeCmd.Flags().StringVar(&webhookSecret, webhookSecretFlag, "",
+		"reject webhook requests without an X-Hub-Signature-256 header made with this secret [$"+webhookSecretEnvVar+"]")
+}
+
+var ServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Index git commits received by webhook",
+	Long: `Serve an HTTP endpoint that accepts GitHub push payloads or {"repo": ..., "commit": ...} JSON
+at ` + server.WebhookPath + ` and indexes the commits one at a time.  Queue depth and the result of
+the last commit indexed are available at ` + server.StatusPath + `, and Prometheus metrics at ` + server.MetricsPath + `.`,
+	Example: `  go-ead-indexer serve --git-repo=[path] --address=":8080" --branch=main`,
+	Args:    serveCheckArgs,
+	RunE:    runServeCmd,
+}
+
+// runServeCmd is the main function for the 'serve' command
+// It initializes the logger and Solr client, then serves until it receives
+// SIGINT or SIGTERM.  A commit that is being indexed when the signal is
+// received is rolled back.
+func runServeCmd(cmd *cobra.Command, args []string) error {
+
+	// initialize logger
+	err := initLogger()
+	if err != nil {
+		emsg := fmt.Sprintf("couldn't initialize logger: %s", err)
+		return logAndReturnError(emsg)
+	}
+
+	// initialize Solr client
+	err = initSolrClient(cmd)
+	if err != nil {
+		emsg := fmt.Sprintf("couldn't initialize Solr client: %s", err)
+		return logAndReturnError(emsg)
+	}
+
+	webhookServer, err := server.New(server.Options{
+		Branch:        gitBranch,
+		GitRepoPath:   gitRepoPath,
+		Indexer:       indexer,
+		Logger:        logger,
+		WebhookSecret: getWebhookSecret(cmd),
+	})
+	if err != nil {
+		emsg := fmt.Sprintf("couldn't create server: %s", err)
+		return logAndReturnError(emsg)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	err = webhookServer.ListenAndServe(ctx, serveAddress)
+	if err != nil {
+		emsg := fmt.Sprintf("problem serving on %s: %s", serveAddress, err)
+		return logAndReturnError(emsg)
+	}
+
+	logger.Info(index.MessageKey, fmt.Sprintf("SUCCESS: stopped serving on %s", serveAddress))
+	return nil
+}
+
+// getWebhookSecret returns the --webhook-secret flag value, or the environment
+// variable if the flag is not set on the command line.
+func getWebhookSecret(cmd *cobra.Command) string {
+	if value, ok := getEnvForUnsetFlag(cmd, webhookSecretFlag, webhookSecretEnvVar); ok {
+		return value
+	}
+
+	return webhookSecret
+}
+
+func serveCheckArgs(cmd *cobra.Command, args []string) error {
+	if gitRepoPath == "" {
+		return fmt.Errorf("%s", eMsgGitRepoNotSet)
+	}
+
+	// arguments are OK so disable Cobra's usage output on error
+	cmd.SilenceUsage = true
+
+	return nil
+}
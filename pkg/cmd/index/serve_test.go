@@ -0,0 +1,103 @@
+package index
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/cmd/testutils"
+	"github.com/spf13/cobra"
+)
+
+func TestServe_ArgumentValidation(t *testing.T) {
+	resetServeArgs()
+
+	scenarios := []struct {
+		GitRepoPath string
+		Want        string
+	}{
+		{"", eMsgGitRepoNotSet},         // fail: the git-repo flag is not set
+		{gitSourceRepoPathAbsolute, ""}, // pass: the git-repo flag is set
+	}
+
+	for _, scenario := range scenarios {
+		testutils.SetCmdFlag(ServeCmd, "git-repo", scenario.GitRepoPath)
+
+		want := scenario.Want
+		got := serveCheckArgs(ServeCmd, []string{})
+
+		switch {
+		case want == "" && got != nil:
+			t.Errorf("expected no error but got: %v", got)
+		case want != "" && got == nil:
+			t.Errorf("expected an error but got nothing")
+		case (want != "" && got != nil) && (got.Error() != want):
+			t.Errorf("expected error message: '%s', but got '%s'", want,
+				got.Error())
+		}
+	}
+}
+
+func TestServe_InitSolrClientError(t *testing.T) {
+	resetServeArgs()
+
+	err := os.Setenv("SOLR_ORIGIN_WITH_PORT", "this is not a valid url")
+	if err != nil {
+		t.Errorf("error setting environment variable: %v", err)
+		t.FailNow()
+	}
+
+	testutils.SetCmdFlag(ServeCmd, "git-repo", gitSourceRepoPathAbsolute)
+	testutils.SetCmdFlag(ServeCmd, "logging-level", "debug")
+	gotStdOut, _, _ := testutils.CaptureCmdStdoutStderrE(runServeCmd,
+		ServeCmd, []string{})
+
+	if gotStdOut == "" {
+		t.Errorf("expected data on StdOut but got nothing")
+	}
+
+	testutils.CheckStringContains(t, gotStdOut,
+		`couldn't initialize Solr client: error creating Solr client:`+
+			` parse \"this is not a valid url\": invalid URI for request`)
+}
+
+func TestGetWebhookSecret(t *testing.T) {
+	restoreFlagVariablesAfterTest(t, &webhookSecret)
+
+	testCases := []struct {
+		name     string
+		envVar   string
+		flag     string
+		expected string
+	}{
+		{name: "Not set", expected: ""},
+		{name: "Environment variable", envVar: "env-secret", expected: "env-secret"},
+		{name: "Flag takes precedence over environment variable", envVar: "env-secret",
+			flag: "flag-secret", expected: "flag-secret"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(webhookSecretEnvVar, testCase.envVar)
+
+			cmd := &cobra.Command{}
+			cmd.Flags().StringVar(&webhookSecret, webhookSecretFlag, "", "")
+			if testCase.flag != "" {
+				err := cmd.Flags().Set(webhookSecretFlag, testCase.flag)
+				if err != nil {
+					t.Fatalf("couldn't set --%s: %s", webhookSecretFlag, err)
+				}
+			}
+
+			actual := getWebhookSecret(cmd)
+			if actual != testCase.expected {
+				t.Errorf(`Expected webhook secret "%s", got "%s"`, testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func resetServeArgs() {
+	cmd := ServeCmd
+	cmd.Flags().Set("git-repo", "")
+	cmd.Flags().Set("logging-level", "")
+}
@@ -0,0 +1,40 @@
+package index
+
+import (
+	"fmt"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
+	"github.com/spf13/cobra"
+)
+
+// Solr update format flag, and the environment variable that is used when the
+// flag is not set on the command line.
+const (
+	updateFormatFlag   = "solr-update-format"
+	updateFormatEnvVar = "SOLR_UPDATE_FORMAT"
+)
+
+var updateFormat string // format of Solr update request bodies
+
+func init() {
+	for _, cmd := range []*cobra.Command{DeleteCmd, IndexCmd, ServeCmd} {
+		addUpdateFormatFlag(cmd)
+	}
+}
+
+func addUpdateFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&updateFormat, updateFormatFlag, string(solr.UpdateFormatXML),
+		fmt.Sprintf("format of Solr update requests: %s or %s [$%s]",
+			solr.UpdateFormatXML, solr.UpdateFormatJSON, updateFormatEnvVar))
+}
+
+// getUpdateFormat returns the Solr update format set by the --solr-update-format
+// flag of `cmd`, or the environment variable if the flag is not set on the
+// command line.  The Solr client validates it.
+func getUpdateFormat(cmd *cobra.Command) solr.UpdateFormat {
+	if value, ok := getEnvForUnsetFlag(cmd, updateFormatFlag, updateFormatEnvVar); ok {
+		return solr.UpdateFormat(value)
+	}
+
+	return solr.UpdateFormat(updateFormat)
+}
@@ -0,0 +1,44 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/nyulibraries/go-ead-indexer/pkg/net/solr"
+	"github.com/spf13/cobra"
+)
+
+func TestGetUpdateFormat(t *testing.T) {
+	restoreFlagVariablesAfterTest(t, &updateFormat)
+
+	testCases := []struct {
+		name     string
+		envVar   string
+		flag     string
+		expected solr.UpdateFormat
+	}{
+		{name: "Default", expected: solr.UpdateFormatXML},
+		{name: "Environment variable", envVar: "json", expected: solr.UpdateFormatJSON},
+		{name: "Flag takes precedence over environment variable", envVar: "json", flag: "xml",
+			expected: solr.UpdateFormatXML},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(updateFormatEnvVar, testCase.envVar)
+
+			cmd := &cobra.Command{}
+			addUpdateFormatFlag(cmd)
+			if testCase.flag != "" {
+				err := cmd.Flags().Set(updateFormatFlag, testCase.flag)
+				if err != nil {
+					t.Fatalf("couldn't set --%s: %s", updateFormatFlag, err)
+				}
+			}
+
+			actual := getUpdateFormat(cmd)
+			if actual != testCase.expected {
+				t.Errorf(`Expected update format "%s", got "%s"`, testCase.expected, actual)
+			}
+		})
+	}
+}
@@ -27,4 +27,5 @@ func init() {
 	rootCmd.AddCommand(debug.DebugCmd)
 	rootCmd.AddCommand(index.IndexCmd)
 	rootCmd.AddCommand(index.DeleteCmd)
+	rootCmd.AddCommand(index.ServeCmd)
 }
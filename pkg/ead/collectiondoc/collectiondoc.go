@@ -2,11 +2,15 @@ package collectiondoc
 
 import (
 	"github.com/lestrrat-go/libxml2/types"
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
 )
 
 type CollectionDoc struct {
+	EADVersion     eadutil.EADVersion `json:"ead_version"`
 	Parts          CollectionDocParts `json:"parts"`
 	SolrAddMessage SolrAddMessage     `json:"solr_add_message"`
+
+	options eadutil.Options
 }
 
 // For now, no struct tags for the `CollectionDoc*` fields.  Keep it flat.
@@ -18,18 +22,37 @@ type CollectionDocParts struct {
 }
 
 type CollectionDocComplexParts struct {
-	ChronListComplex CollectionDocPart `json:"chron_list_complex"`
-	CreatorComplex   CollectionDocPart `json:"creator_complex"`
-	DateRange        CollectionDocPart `json:"date_range"`
-	MaterialType     CollectionDocPart `json:"material_type"`
-	Name             CollectionDocPart `json:"name"`
-	OnlineAccess     CollectionDocPart `json:"online_access"`
-	Place            CollectionDocPart `json:"place"`
-	SubjectForFacets CollectionDocPart `json:"subject_for_facets"`
-	UnitDateDisplay  CollectionDocPart `json:"unit_date_display"`
-	UnitDateEnd      CollectionDocPart `json:"unit_date_end"`
-	UnitDateStart    CollectionDocPart `json:"unit_date_start"`
-	UnitTitleHTML    CollectionDocPart `json:"unit_title_html"`
+	AltFormAvailHTML      CollectionDocPart   `json:"alt_form_avail_html"`
+	ArrangementHTML       CollectionDocPart   `json:"arrangement_html"`
+	ChronListComplex      CollectionDocPart   `json:"chron_list_complex"`
+	CreatorComplex        CollectionDocPart   `json:"creator_complex"`
+	DAORole               CollectionDocPart   `json:"dao_role"`
+	DateRangeFacets       map[string][]string `json:"date_range_facets"`
+	DigitalObjects        CollectionDocPart   `json:"digital_objects"`
+	ExtentBoxes           CollectionDocPart   `json:"extent_boxes"`
+	ExtentGB              CollectionDocPart   `json:"extent_gb"`
+	ExtentItems           CollectionDocPart   `json:"extent_items"`
+	ExtentLinearFeet      CollectionDocPart   `json:"extent_linear_feet"`
+	ExtentUnit            CollectionDocPart   `json:"extent_unit"`
+	MaterialType          CollectionDocPart   `json:"material_type"`
+	Name                  CollectionDocPart   `json:"name"`
+	ODDHTML               CollectionDocPart   `json:"odd_html"`
+	OnlineAccess          CollectionDocPart   `json:"online_access"`
+	OriginalsLocHTML      CollectionDocPart   `json:"originals_loc_html"`
+	OtherFindAidHTML      CollectionDocPart   `json:"other_find_aid_html"`
+	Place                 CollectionDocPart   `json:"place"`
+	PreferCiteHTML        CollectionDocPart   `json:"prefer_cite_html"`
+	ProcessInfoHTML       CollectionDocPart   `json:"process_info_html"`
+	RelatedMaterialHTML   CollectionDocPart   `json:"related_material_html"`
+	Restricted            CollectionDocPart   `json:"restricted"`
+	Script                CollectionDocPart   `json:"script"`
+	SeparatedMaterialHTML CollectionDocPart   `json:"separated_material_html"`
+	SubjectForFacets      CollectionDocPart   `json:"subject_for_facets"`
+	UnitDateDisplay       CollectionDocPart   `json:"unit_date_display"`
+	UnitDateEnd           CollectionDocPart   `json:"unit_date_end"`
+	UnitDateRange         CollectionDocPart   `json:"unit_date_range"`
+	UnitDateStart         CollectionDocPart   `json:"unit_date_start"`
+	UnitTitleHTML         CollectionDocPart   `json:"unit_title_html"`
 }
 
 type CollectionDocHardcodedParts struct {
@@ -39,8 +62,11 @@ type CollectionDocHardcodedParts struct {
 
 type CollectionDocXPathParts struct {
 	Abstract                CollectionDocPart `json:"abstract"`
+	AccessRestrict          CollectionDocPart `json:"access_restrict"`
 	AcqInfo                 CollectionDocPart `json:"acq_info"`
+	AltFormAvail            CollectionDocPart `json:"alt_form_avail"`
 	Appraisal               CollectionDocPart `json:"appraisal"`
+	Arrangement             CollectionDocPart `json:"arrangement"`
 	Author                  CollectionDocPart `json:"author"`
 	BiogHist                CollectionDocPart `json:"biog_hist"`
 	ChronList               CollectionDocPart `json:"chron_list"`
@@ -53,7 +79,9 @@ type CollectionDocXPathParts struct {
 	CreatorPersName         CollectionDocPart `json:"creator_pers_name"`
 	CustodHist              CollectionDocPart `json:"custod_hist"`
 	DAO                     CollectionDocPart `json:"dao"`
+	DAOLink                 CollectionDocPart `json:"dao_link"`
 	EADID                   CollectionDocPart `json:"eadid"`
+	Extent                  CollectionDocPart `json:"extent"`
 	FamName                 CollectionDocPart `json:"fam_name"`
 	FamNameNotInDSC         CollectionDocPart `json:"fam_name_not_in_dsc"`
 	Function                CollectionDocPart `json:"function"`
@@ -63,14 +91,23 @@ type CollectionDocXPathParts struct {
 	GeogNameNotInDSC        CollectionDocPart `json:"geog_name_not_in_dsc"`
 	Heading                 CollectionDocPart `json:"heading"`
 	LangCode                CollectionDocPart `json:"lang_code"`
+	LangMaterialNote        CollectionDocPart `json:"lang_material_note"`
 	Language                CollectionDocPart `json:"language"`
 	NameNotInDSC            CollectionDocPart `json:"name_not_in_dsc"`
 	NoteNotInDSC            CollectionDocPart `json:"note_not_in_dsc"`
+	ODD                     CollectionDocPart `json:"odd"`
 	OccupationNotInDSC      CollectionDocPart `json:"occupation_not_in_dsc"`
+	OriginalsLoc            CollectionDocPart `json:"originals_loc"`
+	OtherFindAid            CollectionDocPart `json:"other_find_aid"`
 	PersName                CollectionDocPart `json:"pers_name"`
 	PersNameNotInDSC        CollectionDocPart `json:"pers_name_not_in_dsc"`
 	Phystech                CollectionDocPart `json:"phystech"`
+	PreferCite              CollectionDocPart `json:"prefer_cite"`
+	ProcessInfo             CollectionDocPart `json:"process_info"`
+	RelatedMaterial         CollectionDocPart `json:"related_material"`
 	ScopeContent            CollectionDocPart `json:"scope_content"`
+	ScriptCode              CollectionDocPart `json:"script_code"`
+	SeparatedMaterial       CollectionDocPart `json:"separated_material"`
 	Subject                 CollectionDocPart `json:"subject"`
 	SubjectNotInDSC         CollectionDocPart `json:"subject_not_in_dsc"`
 	TitleNotInDSC           CollectionDocPart `json:"title_not_in_dsc"`
@@ -80,6 +117,7 @@ type CollectionDocXPathParts struct {
 	UnitDateNormal          CollectionDocPart `json:"unit_date_normal"`
 	UnitID                  CollectionDocPart `json:"unit_id"`
 	UnitTitle               CollectionDocPart `json:"unit_title"`
+	UseRestrict             CollectionDocPart `json:"use_restrict"`
 }
 
 type CollectionDocPart struct {
@@ -89,13 +127,16 @@ type CollectionDocPart struct {
 }
 
 // See `ead.new()` comment on why we have to pass in `repositoryCode` as an argument.
-func MakeCollectionDoc(repositoryCode string, node types.Node) (CollectionDoc, error) {
+func MakeCollectionDoc(repositoryCode string, eadVersion eadutil.EADVersion,
+	options eadutil.Options, node types.Node) (CollectionDoc, error) {
 	newCollectionDoc := CollectionDoc{
+		EADVersion: eadVersion,
 		Parts: CollectionDocParts{
 			RepositoryCode: CollectionDocPart{
 				Values: []string{repositoryCode},
 			},
 		},
+		options: options,
 	}
 
 	err := newCollectionDoc.setParts(node)
@@ -114,6 +155,13 @@ func (collectionDoc *CollectionDoc) setParts(node types.Node) error {
 		return err
 	}
 
+	if collectionDoc.EADVersion == eadutil.EADVersion3 {
+		err = collectionDoc.setEAD3XPathSimpleParts(node)
+		if err != nil {
+			return err
+		}
+	}
+
 	// TODO: See TODO in `setComplexParts()` regarding return value.
 	collectionDoc.setComplexParts()
 	collectionDoc.setHardcodedParts()
@@ -3,6 +3,7 @@ package collectiondoc
 import (
 	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
 	"github.com/nyulibraries/go-ead-indexer/pkg/util"
+	"slices"
 	"strings"
 )
 
@@ -17,17 +18,29 @@ func (collectionDoc *CollectionDoc) setComplexParts() []error {
 
 	collectionDoc.setChronListComplex()
 	collectionDoc.setCreatorComplex()
-	collectionDoc.setDateRange()
+	collectionDoc.setDateRangeFacets()
+	digitalObjectsError := collectionDoc.setDigitalObjects()
+	if digitalObjectsError != nil {
+		errs = append(errs, digitalObjectsError)
+	}
+	collectionDoc.setExtentQuantities()
 	languageErrors := collectionDoc.setLanguage()
 	if len(languageErrors) > 0 {
 		errs = append(errs, languageErrors...)
 	}
 	collectionDoc.setMaterialType()
 	collectionDoc.setName()
+	noteHTMLError := collectionDoc.setNoteHTML()
+	if noteHTMLError != nil {
+		errs = append(errs, noteHTMLError)
+	}
 	collectionDoc.setOnlineAccess()
 	collectionDoc.setPlace()
+	collectionDoc.setRestricted()
+	collectionDoc.setScript()
 	collectionDoc.setSubjectForFacets()
 	collectionDoc.setUnitDateEnd()
+	collectionDoc.setUnitDateRange()
 	collectionDoc.setUnitDateStart()
 	collectionDoc.setUnitDateDisplay()
 	unitTitleHTMLError := collectionDoc.setUnitTitleHTML()
@@ -62,18 +75,73 @@ func (collectionDoc *CollectionDoc) setCreatorComplex() {
 	parts.CreatorComplex.Values = creatorComplexValues
 }
 
-func (collectionDoc *CollectionDoc) setDateRange() {
-	collectionDoc.Parts.DateRange.Values =
-		eadutil.GetDateRange(collectionDoc.Parts.UnitDateNormal.Values)
+func (collectionDoc *CollectionDoc) setDateRangeFacets() {
+	collectionDoc.Parts.DateRangeFacets =
+		eadutil.GetDateRangeFacetValues(collectionDoc.Parts.UnitDateNormal.Values,
+			collectionDoc.options.DateRangeFacets)
+}
+
+func (collectionDoc *CollectionDoc) setDigitalObjects() error {
+	parts := &collectionDoc.Parts
+
+	digitalObjects, err := eadutil.GetDigitalObjects(parts.DAOLink.XMLStrings)
+	if err != nil {
+		return err
+	}
+
+	digitalObjectValues := []string{}
+	daoRoleValues := []string{}
+	for _, digitalObject := range digitalObjects {
+		if digitalObject.Href == "" {
+			continue
+		}
+
+		digitalObjectValue, err := eadutil.MakeDigitalObjectJSON(digitalObject)
+		if err != nil {
+			return err
+		}
+
+		digitalObjectValues = append(digitalObjectValues, digitalObjectValue)
+		if digitalObject.Role != "" {
+			daoRoleValues = append(daoRoleValues, digitalObject.Role)
+		}
+	}
+
+	parts.DigitalObjects.Values = digitalObjectValues
+	parts.DAORole.Values = util.CompactStringSlicePreserveOrder(daoRoleValues)
+
+	return nil
+}
+
+func (collectionDoc *CollectionDoc) setExtentQuantities() {
+	parts := &collectionDoc.Parts
+
+	for _, extentQuantity := range eadutil.GetExtentQuantities(parts.Extent.Values) {
+		quantity := []string{extentQuantity.Quantity}
+		switch extentQuantity.Unit {
+		case eadutil.ExtentUnitBoxes:
+			parts.ExtentBoxes.Values = quantity
+		case eadutil.ExtentUnitGB:
+			parts.ExtentGB.Values = quantity
+		case eadutil.ExtentUnitItems:
+			parts.ExtentItems.Values = quantity
+		case eadutil.ExtentUnitLinearFeet:
+			parts.ExtentLinearFeet.Values = quantity
+		}
+
+		parts.ExtentUnit.Values = append(parts.ExtentUnit.Values, extentQuantity.Unit)
+	}
 }
 
 func (collectionDoc *CollectionDoc) setLanguage() []error {
-	language, errs := eadutil.GetLanguage(collectionDoc.Parts.LangCode.Values)
+	language, errs := eadutil.GetLanguage(collectionDoc.Parts.LangCode.Values, collectionDoc.options.LanguageMode)
 	if len(errs) > 0 {
 		return errs
 	}
 
-	collectionDoc.Parts.Language.Values = language
+	collectionDoc.Parts.Language.Values = append(language,
+		eadutil.GetLanguagesInNotes(collectionDoc.Parts.LangMaterialNote.Values,
+			collectionDoc.options.LanguageMode)...)
 
 	return nil
 }
@@ -96,8 +164,36 @@ func (collectionDoc *CollectionDoc) setName() {
 	parts.Name.Values = nameValues
 }
 
+func (collectionDoc *CollectionDoc) setNoteHTML() error {
+	parts := &collectionDoc.Parts
+
+	for _, notePart := range []struct {
+		xpathPart *CollectionDocPart
+		htmlPart  *CollectionDocPart
+	}{
+		{&parts.AltFormAvail, &parts.AltFormAvailHTML},
+		{&parts.Arrangement, &parts.ArrangementHTML},
+		{&parts.ODD, &parts.ODDHTML},
+		{&parts.OriginalsLoc, &parts.OriginalsLocHTML},
+		{&parts.OtherFindAid, &parts.OtherFindAidHTML},
+		{&parts.PreferCite, &parts.PreferCiteHTML},
+		{&parts.ProcessInfo, &parts.ProcessInfoHTML},
+		{&parts.RelatedMaterial, &parts.RelatedMaterialHTML},
+		{&parts.SeparatedMaterial, &parts.SeparatedMaterialHTML},
+	} {
+		noteHTMLValues, err := eadutil.MakeNoteHTML(notePart.xpathPart.XMLStrings)
+		if err != nil {
+			return err
+		}
+
+		notePart.htmlPart.Values = noteHTMLValues
+	}
+
+	return nil
+}
+
 func (collectionDoc *CollectionDoc) setOnlineAccess() {
-	if len(collectionDoc.Parts.DAO.Values) > 0 {
+	if slices.ContainsFunc(collectionDoc.Parts.DAO.Values, util.IsNonEmptyString) {
 		collectionDoc.Parts.OnlineAccess.Values = []string{"Online Access"}
 	}
 }
@@ -107,6 +203,17 @@ func (collectionDoc *CollectionDoc) setPlace() {
 		eadutil.ConvertToFacetSlice(collectionDoc.Parts.GeogName.Values)
 }
 
+func (collectionDoc *CollectionDoc) setRestricted() {
+	restricted := eadutil.GetRestricted(collectionDoc.Parts.AccessRestrict.Values)
+	if restricted != "" {
+		collectionDoc.Parts.Restricted.Values = []string{restricted}
+	}
+}
+
+func (collectionDoc *CollectionDoc) setScript() {
+	collectionDoc.Parts.Script.Values = eadutil.GetScript(collectionDoc.Parts.ScriptCode.Values)
+}
+
 func (collectionDoc *CollectionDoc) setSubjectForFacets() {
 	collectionDoc.Parts.SubjectForFacets.Values =
 		eadutil.ConvertToFacetSlice(collectionDoc.Parts.Subject.Values)
@@ -135,6 +242,20 @@ func (collectionDoc *CollectionDoc) setUnitDateEnd() {
 	parts.UnitDateEnd.Values = unitDateEndValues
 }
 
+func (collectionDoc *CollectionDoc) setUnitDateRange() {
+	parts := &collectionDoc.Parts
+
+	unitDateRangeValues := []string{}
+	for _, unitDateNormal := range parts.UnitDateNormal.Values {
+		unitDateRange := eadutil.MakeDateRangeFieldValue(eadutil.GetDateParts(unitDateNormal))
+		if unitDateRange != "" {
+			unitDateRangeValues = append(unitDateRangeValues, unitDateRange)
+		}
+	}
+
+	parts.UnitDateRange.Values = unitDateRangeValues
+}
+
 func (collectionDoc *CollectionDoc) setUnitDateStart() {
 	parts := &collectionDoc.Parts
 
@@ -0,0 +1,96 @@
+package collectiondoc
+
+import (
+	"github.com/lestrrat-go/libxml2/types"
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
+)
+
+// setEAD3XPathSimpleParts resets the parts whose `setXPathSimpleParts()`
+// queries don't match the EAD3 equivalents of the EAD 2002 elements and
+// attributes they were written for.  All other parts are the same in both
+// versions.
+func (collectionDoc *CollectionDoc) setEAD3XPathSimpleParts(node types.Node) error {
+	var err error
+
+	parts := &collectionDoc.Parts
+
+	parts.EADID.Source = "//control/recordid"
+	parts.EADID.Values, parts.EADID.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.EADID.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.Extent.Source = "//archdesc[@level='collection']/did/*[self::physdesc or self::physdescstructured] | //archdesc[@level='collection']/did/physdescset/physdescstructured"
+	parts.Extent.Values, parts.Extent.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.Extent.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.Function.Source = "//archdesc[@level='collection']/*[name() != 'dsc']//function | //archdesc[@level='collection']/relations/relation[@relationtype='functionrelation']/relationentry"
+	parts.Function.Values, parts.Function.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Function.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.LangCode.Source = "//archdesc[@level='collection']/did/langmaterial//language/@langcode"
+	parts.LangCode.Values, parts.LangCode.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.LangCode.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.LangMaterialNote.Source = "//archdesc[@level='collection']/did/langmaterial/descriptivenote"
+	parts.LangMaterialNote.Values, parts.LangMaterialNote.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.LangMaterialNote.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.NameNotInDSC.Source = "//archdesc[@level='collection']/*[name() != 'dsc']//name | //archdesc[@level='collection']/relations/relation[@relationtype='cpfrelation']/relationentry"
+	parts.NameNotInDSC.Values, parts.NameNotInDSC.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.NameNotInDSC.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.NoteNotInDSC.Source = "//archdesc[@level='collection']/*[name() != 'dsc']//*[self::note or self::didnote]"
+	parts.NoteNotInDSC.Values, parts.NoteNotInDSC.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.NoteNotInDSC.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.ScriptCode.Source = "//archdesc[@level='collection']/did/langmaterial//script/@scriptcode"
+	parts.ScriptCode.Values, parts.ScriptCode.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ScriptCode.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateBulk.Source = "//archdesc[@level='collection']/did/*[self::unitdate or self::unitdatestructured][@unitdatetype='bulk']"
+	parts.UnitDateBulk.Values, parts.UnitDateBulk.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.UnitDateBulk.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateInclusive.Source = "//archdesc[@level='collection']/did/*[self::unitdate or self::unitdatestructured][@unitdatetype='inclusive']"
+	parts.UnitDateInclusive.Values, parts.UnitDateInclusive.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.UnitDateInclusive.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateNormal.Source = "//archdesc[@level='collection']/did/unitdate/@normal | //archdesc[@level='collection']/did/unitdatestructured"
+	parts.UnitDateNormal.Values, parts.UnitDateNormal.XMLStrings, err = eadutil.GetEAD3UnitDateNormalValuesAndXMLStrings(parts.UnitDateNormal.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateNoTypeAttribute.Source = "//archdesc[@level='collection']/did/*[self::unitdate or self::unitdatestructured][not(@unitdatetype)]"
+	parts.UnitDateNoTypeAttribute.Values, parts.UnitDateNoTypeAttribute.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.UnitDateNoTypeAttribute.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitID.Source = "//archdesc[@level='collection']/did/unitid[not(@localtype='aspace_uri')]"
+	parts.UnitID.Values, parts.UnitID.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.UnitID.Source, node)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
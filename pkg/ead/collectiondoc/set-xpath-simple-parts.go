@@ -16,18 +16,36 @@ func (collectionDoc *CollectionDoc) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.AccessRestrict.Source = "//archdesc[@level='collection']/accessrestrict/p"
+	parts.AccessRestrict.Values, parts.AccessRestrict.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AccessRestrict.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.AcqInfo.Source = "//archdesc[@level='collection']/acqinfo/p"
 	parts.AcqInfo.Values, parts.AcqInfo.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AcqInfo.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.AltFormAvail.Source = "//archdesc[@level='collection']/altformavail/p"
+	parts.AltFormAvail.Values, parts.AltFormAvail.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AltFormAvail.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Appraisal.Source = "//archdesc[@level='collection']/appraisal/p"
 	parts.Appraisal.Values, parts.Appraisal.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Appraisal.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.Arrangement.Source = "//archdesc[@level='collection']/arrangement/p"
+	parts.Arrangement.Values, parts.Arrangement.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Arrangement.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Author.Source = "//filedesc/titlestmt/author"
 	parts.Author.Values, parts.Author.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Author.Source, node)
 	if err != nil {
@@ -109,18 +127,31 @@ func (collectionDoc *CollectionDoc) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
-	parts.DAO.Source = "//dao"
+	// Links anywhere in the EAD, for the "Online Access" facet.
+	parts.DAO.Source = "//*[self::dao or self::daoloc]/@*[local-name()='href']"
 	parts.DAO.Values, parts.DAO.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.DAO.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.DAOLink.Source = "//archdesc[@level='collection']//*[self::dao or self::daoloc][not(ancestor::dsc)]"
+	parts.DAOLink.Values, parts.DAOLink.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.DAOLink.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.EADID.Source = "//eadid"
 	parts.EADID.Values, parts.EADID.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.EADID.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.Extent.Source = "//archdesc[@level='collection']/did/physdesc/extent"
+	parts.Extent.Values, parts.Extent.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Extent.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.FamName.Source = "//famname"
 	parts.FamName.Values, parts.FamName.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.FamName.Source, node)
 	if err != nil {
@@ -169,6 +200,12 @@ func (collectionDoc *CollectionDoc) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.LangMaterialNote.Source = "//archdesc[@level='collection']/did/langmaterial[not(language)]"
+	parts.LangMaterialNote.Values, parts.LangMaterialNote.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.LangMaterialNote.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.NameNotInDSC.Source = "//archdesc[@level='collection']/*[name() != 'dsc']//name"
 	parts.NameNotInDSC.Values, parts.NameNotInDSC.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.NameNotInDSC.Source, node)
 	if err != nil {
@@ -187,6 +224,24 @@ func (collectionDoc *CollectionDoc) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.ODD.Source = "//archdesc[@level='collection']/odd/p"
+	parts.ODD.Values, parts.ODD.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ODD.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.OriginalsLoc.Source = "//archdesc[@level='collection']/originalsloc/p"
+	parts.OriginalsLoc.Values, parts.OriginalsLoc.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.OriginalsLoc.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.OtherFindAid.Source = "//archdesc[@level='collection']/otherfindaid/p"
+	parts.OtherFindAid.Values, parts.OtherFindAid.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.OtherFindAid.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.PersName.Source = "//persname"
 	parts.PersName.Values, parts.PersName.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.PersName.Source, node)
 	if err != nil {
@@ -205,12 +260,42 @@ func (collectionDoc *CollectionDoc) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.PreferCite.Source = "//archdesc[@level='collection']/prefercite/p"
+	parts.PreferCite.Values, parts.PreferCite.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.PreferCite.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.ProcessInfo.Source = "//archdesc[@level='collection']/processinfo/p"
+	parts.ProcessInfo.Values, parts.ProcessInfo.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ProcessInfo.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.RelatedMaterial.Source = "//archdesc[@level='collection']/relatedmaterial/p"
+	parts.RelatedMaterial.Values, parts.RelatedMaterial.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.RelatedMaterial.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.ScopeContent.Source = "//archdesc[@level='collection']/scopecontent/p"
 	parts.ScopeContent.Values, parts.ScopeContent.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ScopeContent.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.ScriptCode.Source = "//archdesc[@level='collection']/did/langmaterial/language/@scriptcode"
+	parts.ScriptCode.Values, parts.ScriptCode.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ScriptCode.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.SeparatedMaterial.Source = "//archdesc[@level='collection']/separatedmaterial/p"
+	parts.SeparatedMaterial.Values, parts.SeparatedMaterial.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.SeparatedMaterial.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Subject.Source = "//*[local-name()='subject' or local-name()='function' or local-name() = 'occupation']"
 	parts.Subject.Values, parts.Subject.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Subject.Source, node)
 	if err != nil {
@@ -265,6 +350,12 @@ func (collectionDoc *CollectionDoc) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.UseRestrict.Source = "//archdesc[@level='collection']/userestrict/p"
+	parts.UseRestrict.Values, parts.UseRestrict.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.UseRestrict.Source, node)
+	if err != nil {
+		return err
+	}
+
 	// PROXY PARTS
 	// These parts depend on other simple XPath parts, so we do them at the end.
 
@@ -23,8 +23,12 @@ type AddElement struct {
 type DocElement struct {
 	Abstract_ssm           []string `xml:"abstract_ssm"`
 	Abstract_teim          []string `xml:"abstract_teim"`
+	AccessRestrict_ssm     []string `xml:"accessrestrict_ssm"`
+	AccessRestrict_teim    []string `xml:"accessrestrict_teim"`
 	AcqInfo_teim           []string `xml:"acqinfo_teim"`
+	AltFormAvail_teim      []string `xml:"altformavail_teim"`
 	Appraisal_teim         []string `xml:"appraisal_teim"`
+	Arrangement_teim       []string `xml:"arrangement_teim"`
 	Author_ssm             []string `xml:"author_ssm"`
 	Author_teim            []string `xml:"author_teim"`
 	BiogHist_teim          []string `xml:"bioghist_teim"`
@@ -38,9 +42,17 @@ type DocElement struct {
 	Creator_ssm            []string `xml:"creator_ssm"`
 	Creator_teim           []string `xml:"creator_teim"`
 	CustodHist_teim        []string `xml:"custodhist_teim"`
+	DAORole_sim            []string `xml:"dao_role_sim"`
 	DAO_sim                string   `xml:"dao_sim"`
-	DateRange_sim          []string `xml:"date_range_sim"`
+	DigitalObjects_ssm     []string `xml:"digital_objects_ssm"`
 	EAD_ssi                string   `xml:"ead_ssi"`
+	ExtentBoxes_fsi        string   `xml:"extent_boxes_fsi"`
+	ExtentGB_fsi           string   `xml:"extent_gb_fsi"`
+	ExtentItems_fsi        string   `xml:"extent_items_fsi"`
+	ExtentLinearFeet_fsi   string   `xml:"extent_linear_feet_fsi"`
+	ExtentUnit_sim         []string `xml:"extent_unit_sim"`
+	Extent_ssm             []string `xml:"extent_ssm"`
+	Extent_teim            []string `xml:"extent_teim"`
 	FamName_ssm            []string `xml:"famname_ssm"`
 	FamName_teim           []string `xml:"famname_teim"`
 	Format_ii              string   `xml:"format_ii"`
@@ -54,8 +66,10 @@ type DocElement struct {
 	GeogName_teim          []string `xml:"geogname_teim"`
 	Heading_ssm            []string `xml:"heading_ssm"`
 	ID                     string   `xml:"id"`
-	Language_sim           string   `xml:"language_sim"`
-	Language_ssm           string   `xml:"language_ssm"`
+	IndexedAt_dtsi         string   `xml:"indexed_at_dtsi"`
+	IndexerVersion_ssi     string   `xml:"indexer_version_ssi"`
+	Language_sim           []string `xml:"language_sim"`
+	Language_ssm           []string `xml:"language_ssm"`
 	MaterialType_sim       []string `xml:"material_type_sim"`
 	MaterialType_ssm       []string `xml:"material_type_ssm"`
 	Name_sim               []string `xml:"name_sim"`
@@ -63,22 +77,35 @@ type DocElement struct {
 	Name_teim              []string `xml:"name_teim"`
 	Note_ssm               []string `xml:"note_ssm"`
 	Note_teim              []string `xml:"note_teim"`
+	ODD_teim               []string `xml:"odd_teim"`
 	Occupation_ssm         []string `xml:"occupation_ssm"`
 	Occupation_teim        []string `xml:"occupation_teim"`
+	OriginalsLoc_teim      []string `xml:"originalsloc_teim"`
+	OtherFindAid_teim      []string `xml:"otherfindaid_teim"`
 	PersName_ssm           []string `xml:"persname_ssm"`
 	PersName_teim          []string `xml:"persname_teim"`
 	PhysTech_teim          []string `xml:"phystech_teim"`
 	Place_sim              []string `xml:"place_sim"`
+	PreferCite_teim        []string `xml:"prefercite_teim"`
+	ProcessInfo_teim       []string `xml:"processinfo_teim"`
+	RelatedMaterial_teim   []string `xml:"relatedmaterial_teim"`
 	Repository_sim         string   `xml:"repository_sim"`
 	Repository_ssi         string   `xml:"repository_ssi"`
 	Repository_ssm         string   `xml:"repository_ssm"`
+	Restricted_sim         string   `xml:"restricted_sim"`
+	RunID_ssi              string   `xml:"run_id_ssi"`
 	ScopeContent_teim      []string `xml:"scopecontent_teim"`
+	Script_sim             []string `xml:"script_sim"`
+	Script_ssm             []string `xml:"script_ssm"`
+	SeparatedMaterial_teim []string `xml:"separatedmaterial_teim"`
+	SourceCommit_ssi       string   `xml:"source_commit_ssi"`
 	Subject_sim            []string `xml:"subject_sim"`
 	Subject_ssm            []string `xml:"subject_ssm"`
 	Subject_teim           []string `xml:"subject_teim"`
 	Title_ssm              []string `xml:"title_ssm"`
 	Title_teim             []string `xml:"title_teim"`
 	UnitDateBulk_teim      []string `xml:"unitdate_bulk_teim"`
+	UnitDateEnd_isim       []string `xml:"unitdate_end_isim"`
 	UnitDateEnd_si         string   `xml:"unitdate_end_si"`
 	UnitDateEnd_sim        []string `xml:"unitdate_end_sim"`
 	UnitDateEnd_ssm        []string `xml:"unitdate_end_ssm"`
@@ -86,15 +113,23 @@ type DocElement struct {
 	UnitDateNormal_sim     []string `xml:"unitdate_normal_sim"`
 	UnitDateNormal_ssm     []string `xml:"unitdate_normal_ssm"`
 	UnitDateNormal_teim    []string `xml:"unitdate_normal_teim"`
+	UnitDateStart_isim     []string `xml:"unitdate_start_isim"`
 	UnitDateStart_si       string   `xml:"unitdate_start_si"`
 	UnitDateStart_sim      []string `xml:"unitdate_start_sim"`
 	UnitDateStart_ssm      []string `xml:"unitdate_start_ssm"`
+	UnitDate_drsim         []string `xml:"unitdate_drsim"`
 	UnitDate_ssm           []string `xml:"unitdate_ssm"`
 	UnitDate_teim          []string `xml:"unitdate_teim"`
 	UnitID_ssm             []string `xml:"unitid_ssm"`
 	UnitID_teim            []string `xml:"unitid_teim"`
 	UnitTitle_ssm          []string `xml:"unittitle_ssm"`
 	UnitTitle_teim         []string `xml:"unittitle_teim"`
+	UseRestrict_ssm        []string `xml:"userestrict_ssm"`
+	UseRestrict_teim       []string `xml:"userestrict_teim"`
+
+	// Date range facet fields, keyed by field name, which is why they aren't
+	// struct fields.  See `eadutil.GetDateRangeFacetValues()`.
+	DateRangeFacets map[string][]string `xml:"-"`
 }
 
 func (collectionDoc *CollectionDoc) setSolrAddMessage() {
@@ -103,10 +138,17 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 	docElement.Abstract_ssm = append(docElement.Abstract_ssm, collectionDoc.Parts.Abstract.Values...)
 	docElement.Abstract_teim = append(docElement.Abstract_teim, collectionDoc.Parts.Abstract.Values...)
 
+	docElement.AccessRestrict_ssm = append(docElement.AccessRestrict_ssm, collectionDoc.Parts.AccessRestrict.Values...)
+	docElement.AccessRestrict_teim = append(docElement.AccessRestrict_teim, collectionDoc.Parts.AccessRestrict.Values...)
+
 	docElement.AcqInfo_teim = append(docElement.AcqInfo_teim, collectionDoc.Parts.AcqInfo.Values...)
 
+	docElement.AltFormAvail_teim = append(docElement.AltFormAvail_teim, collectionDoc.Parts.AltFormAvailHTML.Values...)
+
 	docElement.Appraisal_teim = append(docElement.Appraisal_teim, collectionDoc.Parts.Appraisal.Values...)
 
+	docElement.Arrangement_teim = append(docElement.Arrangement_teim, collectionDoc.Parts.ArrangementHTML.Values...)
+
 	docElement.Author_ssm = append(docElement.Author_ssm, collectionDoc.Parts.Author.Values...)
 	docElement.Author_teim = append(docElement.Author_teim, collectionDoc.Parts.Author.Values...)
 
@@ -129,15 +171,38 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 
 	docElement.CustodHist_teim = append(docElement.CustodHist_teim, collectionDoc.Parts.CustodHist.Values...)
 
+	docElement.DAORole_sim = append(docElement.DAORole_sim, collectionDoc.Parts.DAORole.Values...)
+
 	if len(collectionDoc.Parts.OnlineAccess.Values) > 0 {
 		docElement.DAO_sim = collectionDoc.Parts.OnlineAccess.Values[0]
 	}
 
-	docElement.DateRange_sim = append(docElement.DateRange_sim,
-		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.DateRange.Values)...)
+	docElement.DateRangeFacets = map[string][]string{}
+	for fieldName, values := range collectionDoc.Parts.DateRangeFacets {
+		docElement.DateRangeFacets[fieldName] = util.CompactStringSlicePreserveOrder(values)
+	}
+
+	docElement.DigitalObjects_ssm = append(docElement.DigitalObjects_ssm, collectionDoc.Parts.DigitalObjects.Values...)
 
 	docElement.EAD_ssi = collectionDoc.Parts.EADID.Values[0]
 
+	docElement.Extent_ssm = append(docElement.Extent_ssm, collectionDoc.Parts.Extent.Values...)
+	docElement.Extent_teim = append(docElement.Extent_teim, collectionDoc.Parts.Extent.Values...)
+
+	if len(collectionDoc.Parts.ExtentBoxes.Values) > 0 {
+		docElement.ExtentBoxes_fsi = collectionDoc.Parts.ExtentBoxes.Values[0]
+	}
+	if len(collectionDoc.Parts.ExtentGB.Values) > 0 {
+		docElement.ExtentGB_fsi = collectionDoc.Parts.ExtentGB.Values[0]
+	}
+	if len(collectionDoc.Parts.ExtentItems.Values) > 0 {
+		docElement.ExtentItems_fsi = collectionDoc.Parts.ExtentItems.Values[0]
+	}
+	if len(collectionDoc.Parts.ExtentLinearFeet.Values) > 0 {
+		docElement.ExtentLinearFeet_fsi = collectionDoc.Parts.ExtentLinearFeet.Values[0]
+	}
+	docElement.ExtentUnit_sim = append(docElement.ExtentUnit_sim, collectionDoc.Parts.ExtentUnit.Values...)
+
 	docElement.FamName_ssm = append(docElement.FamName_ssm, collectionDoc.Parts.FamNameNotInDSC.Values...)
 	docElement.FamName_teim = append(docElement.FamName_teim, collectionDoc.Parts.FamNameNotInDSC.Values...)
 
@@ -159,10 +224,10 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 
 	docElement.ID = collectionDoc.Parts.EADID.Values[0]
 
-	if len(collectionDoc.Parts.Language.Values) > 0 {
-		docElement.Language_sim = collectionDoc.Parts.Language.Values[0]
-		docElement.Language_ssm = collectionDoc.Parts.Language.Values[0]
-	}
+	docElement.Language_sim = append(docElement.Language_sim,
+		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.Language.Values)...)
+	docElement.Language_ssm = append(docElement.Language_ssm,
+		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.Language.Values)...)
 
 	docElement.MaterialType_sim = append(docElement.MaterialType_sim,
 		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.MaterialType.Values)...)
@@ -182,6 +247,12 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 	docElement.Occupation_ssm = append(docElement.Occupation_ssm, collectionDoc.Parts.OccupationNotInDSC.Values...)
 	docElement.Occupation_teim = append(docElement.Occupation_teim, collectionDoc.Parts.OccupationNotInDSC.Values...)
 
+	docElement.ODD_teim = append(docElement.ODD_teim, collectionDoc.Parts.ODDHTML.Values...)
+
+	docElement.OriginalsLoc_teim = append(docElement.OriginalsLoc_teim, collectionDoc.Parts.OriginalsLocHTML.Values...)
+
+	docElement.OtherFindAid_teim = append(docElement.OtherFindAid_teim, collectionDoc.Parts.OtherFindAidHTML.Values...)
+
 	docElement.PersName_ssm = append(docElement.PersName_ssm, collectionDoc.Parts.PersNameNotInDSC.Values...)
 	docElement.PersName_teim = append(docElement.PersName_teim, collectionDoc.Parts.PersNameNotInDSC.Values...)
 
@@ -190,12 +261,27 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 	docElement.Place_sim = append(docElement.Place_sim,
 		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.Place.Values)...)
 
+	docElement.PreferCite_teim = append(docElement.PreferCite_teim, collectionDoc.Parts.PreferCiteHTML.Values...)
+
+	docElement.ProcessInfo_teim = append(docElement.ProcessInfo_teim, collectionDoc.Parts.ProcessInfoHTML.Values...)
+
+	docElement.RelatedMaterial_teim = append(docElement.RelatedMaterial_teim, collectionDoc.Parts.RelatedMaterialHTML.Values...)
+
 	docElement.Repository_sim = collectionDoc.Parts.RepositoryCode.Values[0]
 	docElement.Repository_ssi = collectionDoc.Parts.RepositoryCode.Values[0]
 	docElement.Repository_ssm = collectionDoc.Parts.RepositoryCode.Values[0]
 
+	if len(collectionDoc.Parts.Restricted.Values) > 0 {
+		docElement.Restricted_sim = collectionDoc.Parts.Restricted.Values[0]
+	}
+
 	docElement.ScopeContent_teim = append(docElement.ScopeContent_teim, collectionDoc.Parts.ScopeContent.Values...)
 
+	docElement.Script_sim = append(docElement.Script_sim,
+		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.Script.Values)...)
+	docElement.Script_ssm = append(docElement.Script_ssm,
+		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.Script.Values)...)
+
 	// See 2nd `Subject_teim` append below.
 	docElement.Subject_sim = append(docElement.Subject_sim,
 		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.SubjectForFacets.Values)...)
@@ -206,11 +292,15 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 	docElement.Subject_teim = append(docElement.Subject_teim,
 		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.SubjectForFacets.Values)...)
 
+	docElement.SeparatedMaterial_teim = append(docElement.SeparatedMaterial_teim, collectionDoc.Parts.SeparatedMaterialHTML.Values...)
+
 	docElement.Title_ssm = append(docElement.Title_ssm, collectionDoc.Parts.TitleNotInDSC.Values...)
 	docElement.Title_teim = append(docElement.Title_teim, collectionDoc.Parts.TitleNotInDSC.Values...)
 
 	docElement.UnitDateBulk_teim = append(docElement.UnitDateBulk_teim, collectionDoc.Parts.UnitDateBulk.Values...)
 
+	docElement.UnitDateEnd_isim = append(docElement.UnitDateEnd_isim,
+		eadutil.MakeIntegerYears(util.CompactStringSlicePreserveOrder(collectionDoc.Parts.UnitDateEnd.Values))...)
 	if len(collectionDoc.Parts.UnitDateEnd.Values) > 0 {
 		docElement.UnitDateEnd_si = collectionDoc.Parts.UnitDateEnd.Values[len(collectionDoc.Parts.UnitDateEnd.Values)-1]
 	}
@@ -225,10 +315,14 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 	docElement.UnitDateNormal_ssm = append(docElement.UnitDateNormal_ssm, collectionDoc.Parts.UnitDateNormal.Values...)
 	docElement.UnitDateNormal_teim = append(docElement.UnitDateNormal_teim, collectionDoc.Parts.UnitDateNormal.Values...)
 
+	docElement.UnitDate_drsim = append(docElement.UnitDate_drsim,
+		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.UnitDateRange.Values)...)
 	docElement.UnitDate_ssm = append(docElement.UnitDate_ssm,
 		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.UnitDateDisplay.Values)...)
 	docElement.UnitDate_teim = append(docElement.UnitDate_teim, collectionDoc.Parts.UnitDateNoTypeAttribute.Values...)
 
+	docElement.UnitDateStart_isim = append(docElement.UnitDateStart_isim,
+		eadutil.MakeIntegerYears(util.CompactStringSlicePreserveOrder(collectionDoc.Parts.UnitDateStart.Values))...)
 	if len(collectionDoc.Parts.UnitDateStart.Values) > 0 {
 		docElement.UnitDateStart_si = collectionDoc.Parts.UnitDateStart.Values[len(collectionDoc.Parts.UnitDateStart.Values)-1]
 	}
@@ -243,6 +337,17 @@ func (collectionDoc *CollectionDoc) setSolrAddMessage() {
 	docElement.UnitTitle_ssm = append(docElement.UnitTitle_ssm,
 		util.CompactStringSlicePreserveOrder(collectionDoc.Parts.UnitTitleHTML.Values)...)
 	docElement.UnitTitle_teim = append(docElement.UnitTitle_teim, collectionDoc.Parts.UnitTitle.Values...)
+
+	docElement.UseRestrict_ssm = append(docElement.UseRestrict_ssm, collectionDoc.Parts.UseRestrict.Values...)
+	docElement.UseRestrict_teim = append(docElement.UseRestrict_teim, collectionDoc.Parts.UseRestrict.Values...)
+}
+
+// JSON returns the message in the Solr JSON update format.  It adds the same
+// document as the XML message returned by `String()`.
+func (solrAddMessage SolrAddMessage) JSON() string {
+	fields := eadutil.GetDocElementFieldsInAlphabeticalOrder(solrAddMessage.Add.Doc)
+
+	return eadutil.MakeSolrAddMessageJSON(fields)
 }
 
 func (solrAddMessage SolrAddMessage) String() string {
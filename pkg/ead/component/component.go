@@ -12,6 +12,8 @@ type Component struct {
 	IDAttribute    string         `json:"IDAttribute"`
 	Parts          ComponentParts `json:"parts"`
 	SolrAddMessage SolrAddMessage `json:"solr_add_message"`
+
+	options eadutil.Options
 }
 
 // For now, no struct tags for the `Component*` fields.  Keep it flat.
@@ -26,43 +28,70 @@ type ComponentParts struct {
 }
 
 type ComponentCollectionDocParts struct {
-	Author           []string `json:"author"`
-	Collection       string   `json:"collection"`
-	CollectionUnitID string   `json:"collection_unit_id"`
-	RepositoryCode   string   `json:"repository_code"`
+	Author           []string           `json:"author"`
+	Collection       string             `json:"collection"`
+	CollectionUnitID string             `json:"collection_unit_id"`
+	EADVersion       eadutil.EADVersion `json:"ead_version"`
+	RepositoryCode   string             `json:"repository_code"`
 }
 
 type ComponentComplexParts struct {
-	ChronListComplex ComponentPart `json:"chron_list_complex"`
-	CreatorComplex   ComponentPart `json:"creator_complex"`
-	DAO              ComponentPart `json:"dao"`
-	DateRange        ComponentPart `json:"date_range"`
-	Format           ComponentPart `json:"format"`
-	Heading          ComponentPart `json:"heading"`
-	Language         ComponentPart `json:"language"`
-	Location         ComponentPart `json:"location"`
-	MaterialType     ComponentPart `json:"material_type"`
-	Name             ComponentPart `json:"name"`
-	Place            ComponentPart `json:"place"`
-	SubjectForFacets ComponentPart `json:"subject_for_facets"`
-	UnitDateDisplay  ComponentPart `json:"unit_date_display"`
-	UnitDateEnd      ComponentPart `json:"unit_date_end"`
-	UnitDateStart    ComponentPart `json:"unit_date_start"`
-	UnitTitleHTML    ComponentPart `json:"unit_title_html"`
+	AccessRestrictComplex ComponentPart       `json:"access_restrict_complex"`
+	AltFormAvailHTML      ComponentPart       `json:"alt_form_avail_html"`
+	ArrangementHTML       ComponentPart       `json:"arrangement_html"`
+	ChronListComplex      ComponentPart       `json:"chron_list_complex"`
+	CreatorComplex        ComponentPart       `json:"creator_complex"`
+	DAO                   ComponentPart       `json:"dao"`
+	DAORole               ComponentPart       `json:"dao_role"`
+	DateRangeFacets       map[string][]string `json:"date_range_facets"`
+	DigitalObjects        ComponentPart       `json:"digital_objects"`
+	ExtentBoxes           ComponentPart       `json:"extent_boxes"`
+	ExtentGB              ComponentPart       `json:"extent_gb"`
+	ExtentItems           ComponentPart       `json:"extent_items"`
+	ExtentLinearFeet      ComponentPart       `json:"extent_linear_feet"`
+	ExtentUnit            ComponentPart       `json:"extent_unit"`
+	Format                ComponentPart       `json:"format"`
+	Heading               ComponentPart       `json:"heading"`
+	Language              ComponentPart       `json:"language"`
+	Location              ComponentPart       `json:"location"`
+	MaterialType          ComponentPart       `json:"material_type"`
+	Name                  ComponentPart       `json:"name"`
+	ODDHTML               ComponentPart       `json:"odd_html"`
+	OriginalsLocHTML      ComponentPart       `json:"originals_loc_html"`
+	OtherFindAidHTML      ComponentPart       `json:"other_find_aid_html"`
+	Place                 ComponentPart       `json:"place"`
+	PreferCiteHTML        ComponentPart       `json:"prefer_cite_html"`
+	ProcessInfoHTML       ComponentPart       `json:"process_info_html"`
+	RelatedMaterialHTML   ComponentPart       `json:"related_material_html"`
+	Restricted            ComponentPart       `json:"restricted"`
+	Script                ComponentPart       `json:"script"`
+	SeparatedMaterialHTML ComponentPart       `json:"separated_material_html"`
+	SubjectForFacets      ComponentPart       `json:"subject_for_facets"`
+	UnitDateDisplay       ComponentPart       `json:"unit_date_display"`
+	UnitDateEnd           ComponentPart       `json:"unit_date_end"`
+	UnitDateRange         ComponentPart       `json:"unit_date_range"`
+	UnitDateStart         ComponentPart       `json:"unit_date_start"`
+	UnitTitleHTML         ComponentPart       `json:"unit_title_html"`
+	UseRestrictComplex    ComponentPart       `json:"use_restrict_complex"`
 }
 
 type ComponentHierarchyParts struct {
-	AncestorUnitTitleList []string      `json:"ancestor_unit_title_list"`
-	ComponentChildren     string        `json:"component_children"`
-	ComponentLevel        string        `json:"component_level"`
-	ParentForDisplay      ComponentPart `json:"parent_for_display"`
-	ParentForSort         string        `json:"parent_for_sort"`
-	SeriesForSort         string        `json:"series_for_sort"`
+	AncestorAccessRestrict ComponentPart `json:"ancestor_access_restrict"`
+	AncestorUnitTitleList  []string      `json:"ancestor_unit_title_list"`
+	AncestorUseRestrict    ComponentPart `json:"ancestor_use_restrict"`
+	ComponentChildren      string        `json:"component_children"`
+	ComponentLevel         string        `json:"component_level"`
+	ParentForDisplay       ComponentPart `json:"parent_for_display"`
+	ParentForSort          string        `json:"parent_for_sort"`
+	SeriesForSort          string        `json:"series_for_sort"`
 }
 
 type ComponentXPathParts struct {
+	AccessRestrict                ComponentPart `json:"access_restrict"`
 	Address                       ComponentPart `json:"address"`
+	AltFormAvail                  ComponentPart `json:"alt_form_avail"`
 	Appraisal                     ComponentPart `json:"appraisal"`
+	Arrangement                   ComponentPart `json:"arrangement"`
 	BiogHist                      ComponentPart `json:"biog_hist"`
 	ChronList                     ComponentPart `json:"chron_list"`
 	CorpName                      ComponentPart `json:"corpname"`
@@ -72,22 +101,33 @@ type ComponentXPathParts struct {
 	CreatorFamName                ComponentPart `json:"creator_fam_name"`
 	CreatorPersName               ComponentPart `json:"creator_pers_name"`
 	DAODescriptionParagraph       ComponentPart `json:"dao"`
+	DAOLink                       ComponentPart `json:"dao_link"`
 	DIDUnitID                     ComponentPart `json:"did_unit_id"`
 	DIDUnitTitle                  ComponentPart `json:"did_unit_title"`
 	EADID                         ComponentPart `json:"eadid"`
+	Extent                        ComponentPart `json:"extent"`
 	FamName                       ComponentPart `json:"fam_name"`
 	Function                      ComponentPart `json:"function"`
 	GenreForm                     ComponentPart `json:"genre_form"`
 	GeogName                      ComponentPart `json:"geog_name"`
 	LangCode                      ComponentPart `json:"language"`
+	LangMaterialNote              ComponentPart `json:"lang_material_note"`
 	Level                         ComponentPart `json:"level"`
 	NameElementAll                ComponentPart `json:"name_element_all"`
 	Note                          ComponentPart `json:"note"`
+	ODD                           ComponentPart `json:"odd"`
 	Occupation                    ComponentPart `json:"occupation"`
+	OriginalsLoc                  ComponentPart `json:"originals_loc"`
+	OtherFindAid                  ComponentPart `json:"other_find_aid"`
 	PersName                      ComponentPart `json:"pers_name"`
 	PhysTech                      ComponentPart `json:"phys_tech"`
+	PreferCite                    ComponentPart `json:"prefer_cite"`
+	ProcessInfo                   ComponentPart `json:"process_info"`
 	Ref                           ComponentPart `json:"ref"`
+	RelatedMaterial               ComponentPart `json:"related_material"`
 	ScopeContent                  ComponentPart `json:"scope_content"`
+	ScriptCode                    ComponentPart `json:"script_code"`
+	SeparatedMaterial             ComponentPart `json:"separated_material"`
 	Subject                       ComponentPart `json:"subject"`
 	SubjectOrFunctionOrOccupation ComponentPart `json:"subject_or_function_or_occupation"`
 	Title                         ComponentPart `json:"title"`
@@ -95,6 +135,7 @@ type ComponentXPathParts struct {
 	UnitDateInclusive             ComponentPart `json:"unit_date_inclusive"`
 	UnitDateNoTypeAttribute       ComponentPart `json:"unit_date_not_type"`
 	UnitDateNormal                ComponentPart `json:"unit_date_normal"`
+	UseRestrict                   ComponentPart `json:"use_restrict"`
 }
 
 type ComponentPart struct {
@@ -113,7 +154,8 @@ type Container struct {
 
 const CElementName = "c"
 
-func MakeComponents(collectionDocParts ComponentCollectionDocParts, node types.Node) (*[]Component, error) {
+func MakeComponents(collectionDocParts ComponentCollectionDocParts, options eadutil.Options,
+	node types.Node) (*[]Component, error) {
 	xpathResult, err := node.Find("//" + CElementName)
 	if err != nil {
 		return nil, err
@@ -138,7 +180,7 @@ func MakeComponents(collectionDocParts ComponentCollectionDocParts, node types.N
 	sort := 0
 	for _, cNode := range cNodes {
 		sort += 1
-		newComponent, err := MakeComponent(collectionDocParts, sort, cNode)
+		newComponent, err := MakeComponent(collectionDocParts, options, sort, cNode)
 		if err != nil {
 			return &components, err
 		}
@@ -161,13 +203,14 @@ func MakeComponents(collectionDocParts ComponentCollectionDocParts, node types.N
 	return &components, nil
 }
 
-func MakeComponent(collectionDocParts ComponentCollectionDocParts, sort int,
-	node types.Node) (Component, error) {
+func MakeComponent(collectionDocParts ComponentCollectionDocParts, options eadutil.Options,
+	sort int, node types.Node) (Component, error) {
 	component := Component{
 		Parts: ComponentParts{
 			ComponentCollectionDocParts: collectionDocParts,
 			Sort:                        sort,
 		},
+		options: options,
 	}
 
 	idNode, err := node.(types.Element).GetAttribute("id")
@@ -218,6 +261,13 @@ func (component *Component) setParts(node types.Node) error {
 		return err
 	}
 
+	if component.Parts.EADVersion == eadutil.EADVersion3 {
+		err = component.setEAD3XPathSimpleParts(nodeCopyWithChildCNodesRemoved)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = component.setContainersPart(nodeCopyWithChildCNodesRemoved)
 	if err != nil {
 		return err
@@ -21,20 +21,37 @@ var archivalSeriesRegExp = regexp.MustCompile(`\Aseries|subseries`)
 // return a single error, should it be an early exit single error as it is now,
 // or should we use `errors.Join()` to wrap the slice of all accumulated errors?
 func (component *Component) setComplexParts() error {
+	component.setAccessRestrictComplex()
 	component.setChronListComplex()
 	component.setCreatorComplex()
+	component.setDateRangeFacets()
+	err := component.setDigitalObjects()
+	if err != nil {
+		return err
+	}
+	// Depends on `Component.setDigitalObjects()`
 	component.setDAO()
-	component.setDateRange()
+	component.setExtentQuantities()
 	component.setFormat()
 	component.setHeading()
-	component.setLanguage()
-	err := component.setLocation()
+	languageErrors := component.setLanguage()
+	if len(languageErrors) > 0 {
+		return errors.Join(languageErrors...)
+	}
+	err = component.setLocation()
 	if err != nil {
 		return err
 	}
 	component.setMaterialType()
 	component.setName()
+	err = component.setNoteHTML()
+	if err != nil {
+		return err
+	}
 	component.setPlace()
+	// Depends on `Component.setAccessRestrictComplex()`
+	component.setRestricted()
+	component.setScript()
 	component.setSubjectForFacets()
 	err = component.setUnitTitleHTML()
 	if err != nil {
@@ -42,7 +59,9 @@ func (component *Component) setComplexParts() error {
 	}
 	component.setUnitDateDisplay()
 	component.setUnitDateEnd()
+	component.setUnitDateRange()
 	component.setUnitDateStart()
+	component.setUseRestrictComplex()
 
 	return nil
 }
@@ -83,6 +102,16 @@ func (component *Component) makeRootContainerSliceAndParentChildContainerMap() (
 	return rootContainers, parentChildContainerMap, err
 }
 
+func (component *Component) setAccessRestrictComplex() {
+	parts := &component.Parts
+
+	if len(parts.AccessRestrict.Values) > 0 {
+		parts.AccessRestrictComplex.Values = parts.AccessRestrict.Values
+	} else {
+		parts.AccessRestrictComplex.Values = parts.AncestorAccessRestrict.Values
+	}
+}
+
 func (component *Component) setChronListComplex() {
 	parts := &component.Parts
 
@@ -110,16 +139,69 @@ func (component *Component) setCreatorComplex() {
 func (component *Component) setDAO() {
 	parts := &component.Parts
 
-	if len(parts.DAODescriptionParagraph.Values) > 0 {
+	if len(parts.DigitalObjects.Values) > 0 {
 		parts.DAO.Values = []string{"Online Access"}
 	} else {
 		// No value
 	}
 }
 
-func (component *Component) setDateRange() {
-	component.Parts.DateRange.Values =
-		eadutil.GetDateRange(component.Parts.UnitDateNormal.Values)
+func (component *Component) setDateRangeFacets() {
+	component.Parts.DateRangeFacets =
+		eadutil.GetDateRangeFacetValues(component.Parts.UnitDateNormal.Values,
+			component.options.DateRangeFacets)
+}
+
+func (component *Component) setDigitalObjects() error {
+	parts := &component.Parts
+
+	digitalObjects, err := eadutil.GetDigitalObjects(parts.DAOLink.XMLStrings)
+	if err != nil {
+		return err
+	}
+
+	digitalObjectValues := []string{}
+	daoRoleValues := []string{}
+	for _, digitalObject := range digitalObjects {
+		if digitalObject.Href == "" {
+			continue
+		}
+
+		digitalObjectValue, err := eadutil.MakeDigitalObjectJSON(digitalObject)
+		if err != nil {
+			return err
+		}
+
+		digitalObjectValues = append(digitalObjectValues, digitalObjectValue)
+		if digitalObject.Role != "" {
+			daoRoleValues = append(daoRoleValues, digitalObject.Role)
+		}
+	}
+
+	parts.DigitalObjects.Values = digitalObjectValues
+	parts.DAORole.Values = util.CompactStringSlicePreserveOrder(daoRoleValues)
+
+	return nil
+}
+
+func (component *Component) setExtentQuantities() {
+	parts := &component.Parts
+
+	for _, extentQuantity := range eadutil.GetExtentQuantities(parts.Extent.Values) {
+		quantity := []string{extentQuantity.Quantity}
+		switch extentQuantity.Unit {
+		case eadutil.ExtentUnitBoxes:
+			parts.ExtentBoxes.Values = quantity
+		case eadutil.ExtentUnitGB:
+			parts.ExtentGB.Values = quantity
+		case eadutil.ExtentUnitItems:
+			parts.ExtentItems.Values = quantity
+		case eadutil.ExtentUnitLinearFeet:
+			parts.ExtentLinearFeet.Values = quantity
+		}
+
+		parts.ExtentUnit.Values = append(parts.ExtentUnit.Values, extentQuantity.Unit)
+	}
 }
 
 func (component *Component) setFormat() {
@@ -142,12 +224,14 @@ func (component *Component) setHeading() {
 }
 
 func (component *Component) setLanguage() []error {
-	language, errs := eadutil.GetLanguage(component.Parts.LangCode.Values)
+	language, errs := eadutil.GetLanguage(component.Parts.LangCode.Values, component.options.LanguageMode)
 	if len(errs) > 0 {
 		return errs
 	}
 
-	component.Parts.Language.Values = language
+	component.Parts.Language.Values = append(language,
+		eadutil.GetLanguagesInNotes(component.Parts.LangMaterialNote.Values,
+			component.options.LanguageMode)...)
 
 	return nil
 }
@@ -211,11 +295,50 @@ func (component *Component) setName() {
 	parts.Name.Values = nameValues
 }
 
+func (component *Component) setNoteHTML() error {
+	parts := &component.Parts
+
+	for _, notePart := range []struct {
+		xpathPart *ComponentPart
+		htmlPart  *ComponentPart
+	}{
+		{&parts.AltFormAvail, &parts.AltFormAvailHTML},
+		{&parts.Arrangement, &parts.ArrangementHTML},
+		{&parts.ODD, &parts.ODDHTML},
+		{&parts.OriginalsLoc, &parts.OriginalsLocHTML},
+		{&parts.OtherFindAid, &parts.OtherFindAidHTML},
+		{&parts.PreferCite, &parts.PreferCiteHTML},
+		{&parts.ProcessInfo, &parts.ProcessInfoHTML},
+		{&parts.RelatedMaterial, &parts.RelatedMaterialHTML},
+		{&parts.SeparatedMaterial, &parts.SeparatedMaterialHTML},
+	} {
+		noteHTMLValues, err := eadutil.MakeNoteHTML(notePart.xpathPart.XMLStrings)
+		if err != nil {
+			return err
+		}
+
+		notePart.htmlPart.Values = noteHTMLValues
+	}
+
+	return nil
+}
+
 func (component *Component) setPlace() {
 	component.Parts.Place.Values =
 		eadutil.ConvertToFacetSlice(component.Parts.GeogName.Values)
 }
 
+func (component *Component) setRestricted() {
+	restricted := eadutil.GetRestricted(component.Parts.AccessRestrictComplex.Values)
+	if restricted != "" {
+		component.Parts.Restricted.Values = []string{restricted}
+	}
+}
+
+func (component *Component) setScript() {
+	component.Parts.Script.Values = eadutil.GetScript(component.Parts.ScriptCode.Values)
+}
+
 func (component *Component) setSubjectForFacets() {
 	component.Parts.SubjectForFacets.Values =
 		eadutil.ConvertToFacetSlice(component.Parts.SubjectOrFunctionOrOccupation.Values)
@@ -244,6 +367,20 @@ func (component *Component) setUnitDateEnd() {
 	parts.UnitDateEnd.Values = unitDateEndValues
 }
 
+func (component *Component) setUnitDateRange() {
+	parts := &component.Parts
+
+	unitDateRangeValues := []string{}
+	for _, unitDateNormal := range parts.UnitDateNormal.Values {
+		unitDateRange := eadutil.MakeDateRangeFieldValue(eadutil.GetDateParts(unitDateNormal))
+		if unitDateRange != "" {
+			unitDateRangeValues = append(unitDateRangeValues, unitDateRange)
+		}
+	}
+
+	parts.UnitDateRange.Values = unitDateRangeValues
+}
+
 func (component *Component) setUnitDateStart() {
 	parts := &component.Parts
 
@@ -280,6 +417,16 @@ func (component *Component) setUnitTitleHTML() error {
 	return nil
 }
 
+func (component *Component) setUseRestrictComplex() {
+	parts := &component.Parts
+
+	if len(parts.UseRestrict.Values) > 0 {
+		parts.UseRestrictComplex.Values = parts.UseRestrict.Values
+	} else {
+		parts.UseRestrictComplex.Values = parts.AncestorUseRestrict.Values
+	}
+}
+
 func makeLocationValuePartForContainer(containerType string, containerValue string) string {
 	containerTypeIntro := ""
 	if containerType != "" {
@@ -1,6 +1,9 @@
 package component
 
-import "github.com/lestrrat-go/libxml2/types"
+import (
+	"github.com/lestrrat-go/libxml2/types"
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
+)
 
 func (component *Component) setContainersPart(node types.Node) error {
 	containers := []Container{}
@@ -13,7 +16,7 @@ func (component *Component) setContainersPart(node types.Node) error {
 
 	containerNodes := xpathResult.NodeList()
 	for _, containerNode := range containerNodes {
-		container, err := makeContainer(containerNode)
+		container, err := makeContainer(containerNode, component.Parts.EADVersion)
 		if err != nil {
 			return err
 		}
@@ -25,7 +28,7 @@ func (component *Component) setContainersPart(node types.Node) error {
 	return nil
 }
 
-func makeContainer(containerNode types.Node) (Container, error) {
+func makeContainer(containerNode types.Node, eadVersion eadutil.EADVersion) (Container, error) {
 	container := Container{
 		Value:     containerNode.NodeValue(),
 		XMLString: containerNode.String(),
@@ -38,7 +41,13 @@ func makeContainer(containerNode types.Node) (Container, error) {
 		return container, err
 	}
 
-	typeAttributeNode, err := containerNode.(types.Element).GetAttribute("type")
+	// EAD3 renamed @type to @localtype.
+	typeAttributeName := "type"
+	if eadVersion == eadutil.EADVersion3 {
+		typeAttributeName = "localtype"
+	}
+
+	typeAttributeNode, err := containerNode.(types.Element).GetAttribute(typeAttributeName)
 	if err == nil {
 		container.Type = typeAttributeNode.Value()
 	} else if err.Error() == "attribute not found" {
@@ -33,6 +33,34 @@ func (component *Component) setHierarchyDataParts(node types.Node) error {
 	// Depends on `Component.setParentForDisplay()`
 	component.setComponentLevel()
 
+	err = component.setAncestorRestrictions(node)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// The restrictions of the nearest ancestor <c> or <archdesc> that has any,
+// which apply to components that don't have their own.  In the XPath, the
+// `[1]` of the reverse `ancestor` axis selects the nearest ancestor.
+func (component *Component) setAncestorRestrictions(node types.Node) error {
+	var err error
+
+	parts := &component.Parts
+
+	parts.AncestorAccessRestrict.Source = "ancestor::*[accessrestrict/p][1]/accessrestrict/p"
+	parts.AncestorAccessRestrict.Values, parts.AncestorAccessRestrict.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AncestorAccessRestrict.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.AncestorUseRestrict.Source = "ancestor::*[userestrict/p][1]/userestrict/p"
+	parts.AncestorUseRestrict.Values, parts.AncestorUseRestrict.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AncestorUseRestrict.Source, node)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,102 @@
+package component
+
+import (
+	"github.com/lestrrat-go/libxml2/types"
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
+)
+
+// setEAD3XPathSimpleParts resets the parts whose `setXPathSimpleParts()`
+// queries don't match the EAD3 equivalents of the EAD 2002 elements and
+// attributes they were written for.  All other parts are the same in both
+// versions.
+func (component *Component) setEAD3XPathSimpleParts(node types.Node) error {
+	var err error
+
+	parts := &component.Parts
+
+	parts.DAODescriptionParagraph.Source = ".//*[self::dao or self::daoset]/descriptivenote/p"
+	parts.DAODescriptionParagraph.Values, parts.DAODescriptionParagraph.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.DAODescriptionParagraph.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.DIDUnitID.Source = ".//did/unitid[not(@localtype='aspace_uri')]"
+	parts.DIDUnitID.Values, parts.DIDUnitID.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.DIDUnitID.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.EADID.Source = "//control/recordid"
+	parts.EADID.Values, parts.EADID.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.EADID.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.Extent.Source = ".//did/*[self::physdesc or self::physdescstructured] | .//did/physdescset/physdescstructured"
+	parts.Extent.Values, parts.Extent.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.Extent.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.Function.Source = ".//function | .//relations/relation[@relationtype='functionrelation']/relationentry"
+	parts.Function.Values, parts.Function.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Function.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.LangCode.Source = ".//did/langmaterial//language/@langcode"
+	parts.LangCode.Values, parts.LangCode.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.LangCode.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.LangMaterialNote.Source = ".//did/langmaterial/descriptivenote"
+	parts.LangMaterialNote.Values, parts.LangMaterialNote.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.LangMaterialNote.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.NameElementAll.Source = ".//name | .//relations/relation[@relationtype='cpfrelation']/relationentry"
+	parts.NameElementAll.Values, parts.NameElementAll.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.NameElementAll.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.Note.Source = ".//*[self::note or self::didnote]"
+	parts.Note.Values, parts.Note.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Note.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.ScriptCode.Source = ".//did/langmaterial//script/@scriptcode"
+	parts.ScriptCode.Values, parts.ScriptCode.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ScriptCode.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateBulk.Source = ".//did/*[self::unitdate or self::unitdatestructured][@unitdatetype='bulk']"
+	parts.UnitDateBulk.Values, parts.UnitDateBulk.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.UnitDateBulk.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateInclusive.Source = ".//did/*[self::unitdate or self::unitdatestructured][@unitdatetype='inclusive']"
+	parts.UnitDateInclusive.Values, parts.UnitDateInclusive.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.UnitDateInclusive.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateNormal.Source = ".//did/unitdate/@normal | .//did/unitdatestructured"
+	parts.UnitDateNormal.Values, parts.UnitDateNormal.XMLStrings, err = eadutil.GetEAD3UnitDateNormalValuesAndXMLStrings(parts.UnitDateNormal.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.UnitDateNoTypeAttribute.Source = ".//did/*[self::unitdate or self::unitdatestructured][not(@unitdatetype)]"
+	parts.UnitDateNoTypeAttribute.Values, parts.UnitDateNoTypeAttribute.XMLStrings, err = eadutil.GetEAD3NodeValuesAndXMLStrings(parts.UnitDateNoTypeAttribute.Source, node)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -20,18 +20,36 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 
 	parts := &component.Parts
 
+	parts.AccessRestrict.Source = ".//accessrestrict/p"
+	parts.AccessRestrict.Values, parts.AccessRestrict.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AccessRestrict.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Address.Source = ".//address/p"
 	parts.Address.Values, parts.Address.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Address.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.AltFormAvail.Source = ".//altformavail/p"
+	parts.AltFormAvail.Values, parts.AltFormAvail.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.AltFormAvail.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Appraisal.Source = ".//appraisal/p"
 	parts.Appraisal.Values, parts.Appraisal.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Appraisal.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.Arrangement.Source = ".//arrangement/p"
+	parts.Arrangement.Values, parts.Arrangement.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Arrangement.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.BiogHist.Source = ".//bioghist/p"
 	parts.BiogHist.Values, parts.BiogHist.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.BiogHist.Source, node)
 	if err != nil {
@@ -107,6 +125,12 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.DAOLink.Source = ".//*[self::dao or self::daoloc]"
+	parts.DAOLink.Values, parts.DAOLink.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.DAOLink.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.DIDUnitID.Source = ".//did/unitid"
 	parts.DIDUnitID.Values, parts.DIDUnitID.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.DIDUnitID.Source, node)
 	if err != nil {
@@ -125,6 +149,12 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.Extent.Source = ".//did/physdesc/extent"
+	parts.Extent.Values, parts.Extent.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Extent.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.FamName.Source = ".//famname"
 	parts.FamName.Values, parts.FamName.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.FamName.Source, node)
 	if err != nil {
@@ -155,6 +185,12 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.LangMaterialNote.Source = ".//did/langmaterial[not(language)]"
+	parts.LangMaterialNote.Values, parts.LangMaterialNote.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.LangMaterialNote.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Level.Source = "./@level"
 	parts.Level.Values, parts.Level.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Level.Source, node)
 	if err != nil {
@@ -179,6 +215,24 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.ODD.Source = ".//odd/p"
+	parts.ODD.Values, parts.ODD.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ODD.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.OriginalsLoc.Source = ".//originalsloc/p"
+	parts.OriginalsLoc.Values, parts.OriginalsLoc.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.OriginalsLoc.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.OtherFindAid.Source = ".//otherfindaid/p"
+	parts.OtherFindAid.Values, parts.OtherFindAid.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.OtherFindAid.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.PersName.Source = ".//persname"
 	parts.PersName.Values, parts.PersName.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.PersName.Source, node)
 	if err != nil {
@@ -191,18 +245,48 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.PreferCite.Source = ".//prefercite/p"
+	parts.PreferCite.Values, parts.PreferCite.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.PreferCite.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.ProcessInfo.Source = ".//processinfo/p"
+	parts.ProcessInfo.Values, parts.ProcessInfo.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ProcessInfo.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Ref.Source = "./@id"
 	parts.Ref.Values, parts.Ref.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Ref.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.RelatedMaterial.Source = ".//relatedmaterial/p"
+	parts.RelatedMaterial.Values, parts.RelatedMaterial.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.RelatedMaterial.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.ScopeContent.Source = ".//scopecontent/p"
 	parts.ScopeContent.Values, parts.ScopeContent.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ScopeContent.Source, node)
 	if err != nil {
 		return err
 	}
 
+	parts.ScriptCode.Source = ".//did/langmaterial/language/@scriptcode"
+	parts.ScriptCode.Values, parts.ScriptCode.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.ScriptCode.Source, node)
+	if err != nil {
+		return err
+	}
+
+	parts.SeparatedMaterial.Source = ".//separatedmaterial/p"
+	parts.SeparatedMaterial.Values, parts.SeparatedMaterial.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.SeparatedMaterial.Source, node)
+	if err != nil {
+		return err
+	}
+
 	parts.Subject.Source = ".//subject"
 	parts.Subject.Values, parts.Subject.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.Subject.Source, node)
 	if err != nil {
@@ -245,5 +329,11 @@ func (component *Component) setXPathSimpleParts(node types.Node) error {
 		return err
 	}
 
+	parts.UseRestrict.Source = ".//userestrict/p"
+	parts.UseRestrict.Values, parts.UseRestrict.XMLStrings, err = eadutil.GetNodeValuesAndXMLStrings(parts.UseRestrict.Source, node)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -22,8 +22,12 @@ type AddElement struct {
 // the struct tags for `DocElement` below are mandatory.  They are used for the
 // field name in the Solr HTTP request XML body.
 type DocElement struct {
+	AccessRestrict_ssm     []string `xml:"accessrestrict_ssm"`
+	AccessRestrict_teim    []string `xml:"accessrestrict_teim"`
 	Address_teim           []string `xml:"address_teim"`
+	AltFormAvail_teim      []string `xml:"altformavail_teim"`
 	Appraisal_teim         []string `xml:"appraisal_teim"`
+	Arrangement_teim       []string `xml:"arrangement_teim"`
 	Author_teim            []string `xml:"author_teim"`
 	BiogHist_teim          []string `xml:"bioghist_teim"`
 	ChronList_teim         []string `xml:"chronlist_teim"`
@@ -39,11 +43,19 @@ type DocElement struct {
 	Creator_sim            []string `xml:"creator_sim"`
 	Creator_ssm            []string `xml:"creator_ssm"`
 	Creator_teim           []string `xml:"creator_teim"`
+	DAORole_sim            []string `xml:"dao_role_sim"`
 	DAO_sim                []string `xml:"dao_sim"`
 	DAO_ssm                []string `xml:"dao_ssm"`
 	DAO_teim               []string `xml:"dao_teim"`
-	DateRange_sim          []string `xml:"date_range_sim"`
+	DigitalObjects_ssm     []string `xml:"digital_objects_ssm"`
 	EAD_ssi                string   `xml:"ead_ssi"`
+	ExtentBoxes_fsi        string   `xml:"extent_boxes_fsi"`
+	ExtentGB_fsi           string   `xml:"extent_gb_fsi"`
+	ExtentItems_fsi        string   `xml:"extent_items_fsi"`
+	ExtentLinearFeet_fsi   string   `xml:"extent_linear_feet_fsi"`
+	ExtentUnit_sim         []string `xml:"extent_unit_sim"`
+	Extent_ssm             []string `xml:"extent_ssm"`
+	Extent_teim            []string `xml:"extent_teim"`
 	FamName_ssm            []string `xml:"famname_ssm"`
 	FamName_teim           []string `xml:"famname_teim"`
 	Format_sim             []string `xml:"format_sim"`
@@ -56,8 +68,10 @@ type DocElement struct {
 	GeogName_teim          []string `xml:"geogname_teim"`
 	Heading_ssm            []string `xml:"heading_ssm"`
 	ID                     string   `xml:"id"`
-	Language_sim           string   `xml:"language_sim"`
-	Language_ssm           string   `xml:"language_ssm"`
+	IndexedAt_dtsi         string   `xml:"indexed_at_dtsi"`
+	IndexerVersion_ssi     string   `xml:"indexer_version_ssi"`
+	Language_sim           []string `xml:"language_sim"`
+	Language_ssm           []string `xml:"language_ssm"`
 	Level_sim              string   `xml:"level_sim"`
 	Location_si            string   `xml:"location_si"`
 	Location_ssm           []string `xml:"location_ssm"`
@@ -68,8 +82,11 @@ type DocElement struct {
 	Name_teim              []string `xml:"name_teim"`
 	Note_ssm               []string `xml:"note_ssm"`
 	Note_teim              []string `xml:"note_teim"`
+	ODD_teim               []string `xml:"odd_teim"`
 	Occupation_ssm         []string `xml:"occupation_ssm"`
 	Occupation_teim        []string `xml:"occupation_teim"`
+	OriginalsLoc_teim      []string `xml:"originalsloc_teim"`
+	OtherFindAid_teim      []string `xml:"otherfindaid_teim"`
 	ParentUnitTitles_ssm   []string `xml:"parent_unittitles_ssm"`
 	ParentUnitTitles_teim  []string `xml:"parent_unittitles_teim"`
 	Parent_ssi             string   `xml:"parent_ssi"`
@@ -79,20 +96,30 @@ type DocElement struct {
 	PhysTech_teim          []string `xml:"phystech_teim"`
 	Place_sim              []string `xml:"place_sim"`
 	Place_ssm              []string `xml:"place_ssm"`
+	PreferCite_teim        []string `xml:"prefercite_teim"`
+	ProcessInfo_teim       []string `xml:"processinfo_teim"`
 	Ref_ssi                string   `xml:"ref_ssi"`
+	RelatedMaterial_teim   []string `xml:"relatedmaterial_teim"`
 	Repository_sim         string   `xml:"repository_sim"`
 	Repository_ssi         string   `xml:"repository_ssi"`
 	Repository_ssm         string   `xml:"repository_ssm"`
+	Restricted_sim         string   `xml:"restricted_sim"`
+	RunID_ssi              string   `xml:"run_id_ssi"`
 	ScopeContent_teim      []string `xml:"scopecontent_teim"`
+	Script_sim             []string `xml:"script_sim"`
+	Script_ssm             []string `xml:"script_ssm"`
+	SeparatedMaterial_teim []string `xml:"separatedmaterial_teim"`
 	Series_si              string   `xml:"series_si"`
 	Series_sim             []string `xml:"series_sim"`
 	Sort_ii                string   `xml:"sort_ii"`
+	SourceCommit_ssi       string   `xml:"source_commit_ssi"`
 	Subject_sim            []string `xml:"subject_sim"`
 	Subject_ssm            []string `xml:"subject_ssm"`
 	Subject_teim           []string `xml:"subject_teim"`
 	Title_ssm              []string `xml:"title_ssm"`
 	Title_teim             []string `xml:"title_teim"`
 	UnitDateBulk_teim      []string `xml:"unitdate_bulk_teim"`
+	UnitDateEnd_isim       []string `xml:"unitdate_end_isim"`
 	UnitDateEnd_si         string   `xml:"unitdate_end_si"`
 	UnitDateEnd_sim        []string `xml:"unitdate_end_sim"`
 	UnitDateEnd_ssm        []string `xml:"unitdate_end_ssm"`
@@ -100,24 +127,39 @@ type DocElement struct {
 	UnitDateNormal_sim     []string `xml:"unitdate_normal_sim"`
 	UnitDateNormal_ssm     []string `xml:"unitdate_normal_ssm"`
 	UnitDateNormal_teim    []string `xml:"unitdate_normal_teim"`
+	UnitDateStart_isim     []string `xml:"unitdate_start_isim"`
 	UnitDateStart_si       string   `xml:"unitdate_start_si"`
 	UnitDateStart_sim      []string `xml:"unitdate_start_sim"`
 	UnitDateStart_ssm      []string `xml:"unitdate_start_ssm"`
+	UnitDate_drsim         []string `xml:"unitdate_drsim"`
 	UnitDate_ssm           []string `xml:"unitdate_ssm"`
 	UnitDate_teim          []string `xml:"unitdate_teim"`
 	UnitID_ssm             []string `xml:"unitid_ssm"`
 	UnitID_teim            []string `xml:"unitid_teim"`
 	UnitTitle_ssm          []string `xml:"unittitle_ssm"`
 	UnitTitle_teim         []string `xml:"unittitle_teim"`
+	UseRestrict_ssm        []string `xml:"userestrict_ssm"`
+	UseRestrict_teim       []string `xml:"userestrict_teim"`
+
+	// Date range facet fields, keyed by field name, which is why they aren't
+	// struct fields.  See `eadutil.GetDateRangeFacetValues()`.
+	DateRangeFacets map[string][]string `xml:"-"`
 }
 
 func (component *Component) setSolrAddMessage() {
 	docElement := &component.SolrAddMessage.Add.Doc
 
+	docElement.AccessRestrict_ssm = component.Parts.AccessRestrictComplex.Values
+	docElement.AccessRestrict_teim = component.Parts.AccessRestrictComplex.Values
+
 	docElement.Address_teim = component.Parts.Address.Values
 
+	docElement.AltFormAvail_teim = component.Parts.AltFormAvailHTML.Values
+
 	docElement.Appraisal_teim = component.Parts.Appraisal.Values
 
+	docElement.Arrangement_teim = component.Parts.ArrangementHTML.Values
+
 	docElement.Author_teim = component.Parts.Author
 
 	docElement.BiogHist_teim = component.Parts.BiogHist.Values
@@ -144,15 +186,38 @@ func (component *Component) setSolrAddMessage() {
 	docElement.Creator_teim = append(docElement.Creator_teim,
 		component.Parts.Creator.Values...)
 
+	docElement.DAORole_sim = component.Parts.DAORole.Values
+
 	docElement.DAO_sim = component.Parts.DAO.Values
 	docElement.DAO_ssm = component.Parts.DAODescriptionParagraph.Values
 	docElement.DAO_teim = component.Parts.DAODescriptionParagraph.Values
 
-	docElement.DateRange_sim = append(docElement.DateRange_sim,
-		util.CompactStringSlicePreserveOrder(component.Parts.DateRange.Values)...)
+	docElement.DateRangeFacets = map[string][]string{}
+	for fieldName, values := range component.Parts.DateRangeFacets {
+		docElement.DateRangeFacets[fieldName] = util.CompactStringSlicePreserveOrder(values)
+	}
+
+	docElement.DigitalObjects_ssm = component.Parts.DigitalObjects.Values
 
 	docElement.EAD_ssi = component.Parts.EADID.Values[0]
 
+	docElement.Extent_ssm = component.Parts.Extent.Values
+	docElement.Extent_teim = component.Parts.Extent.Values
+
+	if len(component.Parts.ExtentBoxes.Values) > 0 {
+		docElement.ExtentBoxes_fsi = component.Parts.ExtentBoxes.Values[0]
+	}
+	if len(component.Parts.ExtentGB.Values) > 0 {
+		docElement.ExtentGB_fsi = component.Parts.ExtentGB.Values[0]
+	}
+	if len(component.Parts.ExtentItems.Values) > 0 {
+		docElement.ExtentItems_fsi = component.Parts.ExtentItems.Values[0]
+	}
+	if len(component.Parts.ExtentLinearFeet.Values) > 0 {
+		docElement.ExtentLinearFeet_fsi = component.Parts.ExtentLinearFeet.Values[0]
+	}
+	docElement.ExtentUnit_sim = component.Parts.ExtentUnit.Values
+
 	docElement.FamName_ssm = component.Parts.FamName.Values
 	docElement.FamName_teim = component.Parts.FamName.Values
 
@@ -172,10 +237,10 @@ func (component *Component) setSolrAddMessage() {
 
 	docElement.Heading_ssm = component.Parts.Heading.Values
 
-	if len(component.Parts.Language.Values) > 0 {
-		docElement.Language_sim = component.Parts.Language.Values[0]
-		docElement.Language_ssm = component.Parts.Language.Values[0]
-	}
+	docElement.Language_sim = append(docElement.Language_sim,
+		util.CompactStringSlicePreserveOrder(component.Parts.Language.Values)...)
+	docElement.Language_ssm = append(docElement.Language_ssm,
+		util.CompactStringSlicePreserveOrder(component.Parts.Language.Values)...)
 
 	docElement.Level_sim = component.Parts.Level.Values[0]
 
@@ -201,6 +266,12 @@ func (component *Component) setSolrAddMessage() {
 	docElement.Occupation_ssm = component.Parts.Occupation.Values
 	docElement.Occupation_teim = component.Parts.Occupation.Values
 
+	docElement.ODD_teim = component.Parts.ODDHTML.Values
+
+	docElement.OriginalsLoc_teim = component.Parts.OriginalsLocHTML.Values
+
+	docElement.OtherFindAid_teim = component.Parts.OtherFindAidHTML.Values
+
 	docElement.Place_sim = component.Parts.Place.Values
 	docElement.Place_ssm = component.Parts.Place.Values
 
@@ -218,6 +289,12 @@ func (component *Component) setSolrAddMessage() {
 
 	docElement.PhysTech_teim = component.Parts.PhysTech.Values
 
+	docElement.PreferCite_teim = component.Parts.PreferCiteHTML.Values
+
+	docElement.ProcessInfo_teim = component.Parts.ProcessInfoHTML.Values
+
+	docElement.RelatedMaterial_teim = component.Parts.RelatedMaterialHTML.Values
+
 	docElement.Repository_sim = component.Parts.RepositoryCode
 	docElement.Repository_ssi = component.Parts.RepositoryCode
 	docElement.Repository_ssm = component.Parts.RepositoryCode
@@ -226,8 +303,19 @@ func (component *Component) setSolrAddMessage() {
 		docElement.Ref_ssi = component.Parts.Ref.Values[0]
 	}
 
+	if len(component.Parts.Restricted.Values) > 0 {
+		docElement.Restricted_sim = component.Parts.Restricted.Values[0]
+	}
+
 	docElement.ScopeContent_teim = component.Parts.ScopeContent.Values
 
+	docElement.Script_sim = append(docElement.Script_sim,
+		util.CompactStringSlicePreserveOrder(component.Parts.Script.Values)...)
+	docElement.Script_ssm = append(docElement.Script_ssm,
+		util.CompactStringSlicePreserveOrder(component.Parts.Script.Values)...)
+
+	docElement.SeparatedMaterial_teim = component.Parts.SeparatedMaterialHTML.Values
+
 	docElement.Series_si = component.Parts.SeriesForSort
 	// This is identical to `docElement.ParentUnitTitles_ssm`.
 	docElement.Series_sim = component.Parts.AncestorUnitTitleList
@@ -252,10 +340,14 @@ func (component *Component) setSolrAddMessage() {
 	docElement.UnitDateNormal_ssm = component.Parts.UnitDateNormal.Values
 	docElement.UnitDateNormal_teim = component.Parts.UnitDateNormal.Values
 
+	docElement.UnitDate_drsim = append(docElement.UnitDate_drsim,
+		util.CompactStringSlicePreserveOrder(component.Parts.UnitDateRange.Values)...)
 	docElement.UnitDate_ssm = append(docElement.UnitDate_ssm,
 		util.CompactStringSlicePreserveOrder(component.Parts.UnitDateDisplay.Values)...)
 	docElement.UnitDate_teim = append(docElement.UnitDate_teim, component.Parts.UnitDateNoTypeAttribute.Values...)
 
+	docElement.UnitDateEnd_isim = append(docElement.UnitDateEnd_isim,
+		eadutil.MakeIntegerYears(util.CompactStringSlicePreserveOrder(component.Parts.UnitDateEnd.Values))...)
 	if len(component.Parts.UnitDateEnd.Values) > 0 {
 		docElement.UnitDateEnd_si = component.Parts.UnitDateEnd.Values[len(component.Parts.UnitDateEnd.Values)-1]
 	}
@@ -264,6 +356,8 @@ func (component *Component) setSolrAddMessage() {
 	docElement.UnitDateEnd_ssm = append(docElement.UnitDateEnd_ssm,
 		util.CompactStringSlicePreserveOrder(component.Parts.UnitDateEnd.Values)...)
 
+	docElement.UnitDateStart_isim = append(docElement.UnitDateStart_isim,
+		eadutil.MakeIntegerYears(util.CompactStringSlicePreserveOrder(component.Parts.UnitDateStart.Values))...)
 	if len(component.Parts.UnitDateStart.Values) > 0 {
 		docElement.UnitDateStart_si = component.Parts.UnitDateStart.Values[len(component.Parts.UnitDateStart.Values)-1]
 	}
@@ -277,6 +371,17 @@ func (component *Component) setSolrAddMessage() {
 
 	docElement.UnitTitle_ssm = component.Parts.UnitTitleHTML.Values
 	docElement.UnitTitle_teim = component.Parts.DIDUnitTitle.Values
+
+	docElement.UseRestrict_ssm = component.Parts.UseRestrictComplex.Values
+	docElement.UseRestrict_teim = component.Parts.UseRestrictComplex.Values
+}
+
+// JSON returns the message in the Solr JSON update format.  It adds the same
+// document as the XML message returned by `String()`.
+func (solrAddMessage SolrAddMessage) JSON() string {
+	fields := eadutil.GetDocElementFieldsInAlphabeticalOrder(solrAddMessage.Add.Doc)
+
+	return eadutil.MakeSolrAddMessageJSON(fields)
 }
 
 func (solrAddMessage SolrAddMessage) String() string {
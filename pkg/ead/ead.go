@@ -9,15 +9,33 @@ import (
 	"github.com/nyulibraries/go-ead-indexer/pkg/ead/component"
 	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
 	"regexp"
+	"time"
 )
 
+// IndexingInfo describes the indexing run that adds the Solr documents for an
+// EAD file, for auditing, finding stale documents, and cleaning up after
+// failed runs.
+type IndexingInfo struct {
+	IndexedAt      time.Time
+	IndexerVersion string
+	// Unique ID of the indexing run.
+	RunID string
+	// Hash of the git commit that the EAD file was indexed from.
+	SourceCommit string
+}
+
 type EAD struct {
 	CollectionDoc        collectiondoc.CollectionDoc `json:"collection_doc"`
 	Components           *[]component.Component      `json:"components"`
+	EADVersion           eadutil.EADVersion          `json:"ead_version"`
 	ModifiedFileContents string                      `json:"modified_file_contents"`
 	OriginalFileContents string                      `json:"original_file_contents"`
 }
 
+// Options configure how the Solr documents are made from an EAD file.  See
+// `eadutil.Options`, which the collection and component packages also use.
+type Options = eadutil.Options
+
 const errorFormatStringInvalidEADID = `"%s" is not a valid EAD ID`
 const errorNoEADTagWithExpectedStructureFound = "No <ead> tag with the expected structure was found"
 
@@ -39,8 +57,22 @@ var validRepositoryCodeRegex = regexp.MustCompile(`^[a-z]+$`)
 // itself.  For now we are keeping file handling out of this package, so it is
 // up to the client to pass in the repository code.
 func New(repositoryCode string, eadXML string) (EAD, error) {
+	return NewWithOptions(repositoryCode, eadXML, Options{})
+}
+
+// NewWithOptions is `New()` with the mapping configured by `options`.
+func NewWithOptions(repositoryCode string, eadXML string, options Options) (EAD, error) {
 	ead := EAD{}
 
+	if options.DateRangeFacets == nil {
+		options.DateRangeFacets = eadutil.DefaultDateRangeFacets()
+	} else {
+		err := eadutil.ValidateDateRangeFacets(options.DateRangeFacets)
+		if err != nil {
+			return ead, err
+		}
+	}
+
 	if !validRepositoryCodeRegex.MatchString(repositoryCode) {
 		return ead, errors.New(fmt.Sprintf(`Invalid repository code: "%s"`,
 			repositoryCode))
@@ -87,7 +119,15 @@ func New(repositoryCode string, eadXML string) (EAD, error) {
 		return ead, err
 	}
 
-	ead.CollectionDoc, err = collectiondoc.MakeCollectionDoc(repositoryCode, rootNode)
+	// EAD3 files are mapped into the same collection and component parts as
+	// EAD 2002 files, using EAD3 equivalents for the queries that differ.
+	ead.EADVersion, err = eadutil.GetEADVersion(rootNode)
+	if err != nil {
+		return ead, err
+	}
+
+	ead.CollectionDoc, err = collectiondoc.MakeCollectionDoc(repositoryCode, ead.EADVersion,
+		options, rootNode)
 	if err != nil {
 		return ead, err
 	}
@@ -102,9 +142,10 @@ func New(repositoryCode string, eadXML string) (EAD, error) {
 		Author:           ead.CollectionDoc.Parts.Author.Values,
 		Collection:       ead.CollectionDoc.Parts.Collection.Values[0],
 		CollectionUnitID: ead.CollectionDoc.Parts.UnitID.Values[0],
+		EADVersion:       ead.EADVersion,
 		RepositoryCode:   repositoryCode,
 	}
-	ead.Components, err = component.MakeComponents(collectionDocParts, rootNode)
+	ead.Components, err = component.MakeComponents(collectionDocParts, options, rootNode)
 	if err != nil {
 		return ead, err
 	}
@@ -121,3 +162,32 @@ func MakeXMLDoc(eadXML string) (types.Document, error) {
 
 	return xmlDoc, nil
 }
+
+// SetIndexingInfo tags the collection-level document and all the
+// component-level documents with information about the indexing run that adds
+// them to Solr.  Empty values are left out of the documents, and documents are
+// not tagged at all unless this is called.
+func (ead *EAD) SetIndexingInfo(info IndexingInfo) {
+	indexedAt := ""
+	if !info.IndexedAt.IsZero() {
+		indexedAt = info.IndexedAt.UTC().Format(time.RFC3339)
+	}
+
+	collectionDocElement := &ead.CollectionDoc.SolrAddMessage.Add.Doc
+	collectionDocElement.IndexedAt_dtsi = indexedAt
+	collectionDocElement.IndexerVersion_ssi = info.IndexerVersion
+	collectionDocElement.RunID_ssi = info.RunID
+	collectionDocElement.SourceCommit_ssi = info.SourceCommit
+
+	if ead.Components == nil {
+		return
+	}
+
+	for i := range *ead.Components {
+		componentDocElement := &(*ead.Components)[i].SolrAddMessage.Add.Doc
+		componentDocElement.IndexedAt_dtsi = indexedAt
+		componentDocElement.IndexerVersion_ssi = info.IndexerVersion
+		componentDocElement.RunID_ssi = info.RunID
+		componentDocElement.SourceCommit_ssi = info.SourceCommit
+	}
+}
@@ -1,18 +1,23 @@
 package ead
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"flag"
 	"fmt"
 	"github.com/nyulibraries/go-ead-indexer/pkg/ead/collectiondoc"
 	"github.com/nyulibraries/go-ead-indexer/pkg/ead/component"
+	"github.com/nyulibraries/go-ead-indexer/pkg/ead/eadutil"
 	"github.com/nyulibraries/go-ead-indexer/pkg/ead/testutils"
 	"github.com/nyulibraries/go-ead-indexer/pkg/util"
 	"os"
 	"path/filepath"
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
+	"time"
 )
 
 var tmpFilesDirPath = filepath.Join("testdata", "tmp", "actual")
@@ -83,6 +88,204 @@ func TestComponentDocSolrAddMessage(t *testing.T) {
 	}
 }
 
+// The JSON messages are not golden-file tested.  Instead, they are checked
+// against the XML messages, which are.
+func TestSolrAddMessageJSON(t *testing.T) {
+	testEADs := testutils.GetTestEADs()
+
+	for _, testEAD := range testEADs {
+		t.Run(testEAD, func(t *testing.T) {
+			eadXML, err := testutils.GetEADFixtureValue(testEAD)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			repositoryCode := testutils.ParseRepositoryCode(testEAD)
+			eadToTest, err := New(repositoryCode, eadXML)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testSolrAddMessageJSON(testutils.ParseEADID(testEAD),
+				eadToTest.CollectionDoc.SolrAddMessage.String(),
+				eadToTest.CollectionDoc.SolrAddMessage.JSON(), t)
+
+			if eadToTest.Components != nil {
+				for _, component := range *eadToTest.Components {
+					testSolrAddMessageJSON(component.ID,
+						component.SolrAddMessage.String(),
+						component.SolrAddMessage.JSON(), t)
+				}
+			}
+		})
+	}
+}
+
+func TestSetIndexingInfo(t *testing.T) {
+	testEAD := filepath.Join("fales", "mss_460")
+	eadXML, err := testutils.GetEADFixtureValue(testEAD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eadToTest, err := New(testutils.ParseRepositoryCode(testEAD), eadXML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fieldNames := []string{"indexed_at_dtsi", "indexer_version_ssi", "run_id_ssi", "source_commit_ssi"}
+	for _, fieldName := range fieldNames {
+		if strings.Contains(eadToTest.CollectionDoc.SolrAddMessage.String(), fieldName) {
+			t.Errorf("Expected no %s field before SetIndexingInfo()", fieldName)
+		}
+	}
+
+	eadToTest.SetIndexingInfo(IndexingInfo{
+		IndexedAt:      time.Date(2025, 1, 1, 7, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+		IndexerVersion: "v1.2.3",
+		RunID:          "20250101T120000Z-0123abcd",
+	})
+
+	expectedFields := []string{
+		`<field name="indexed_at_dtsi">2025-01-01T12:00:00Z</field>`,
+		`<field name="indexer_version_ssi">v1.2.3</field>`,
+		`<field name="run_id_ssi">20250101T120000Z-0123abcd</field>`,
+	}
+	for _, expectedField := range expectedFields {
+		if !strings.Contains(eadToTest.CollectionDoc.SolrAddMessage.String(), expectedField) {
+			t.Errorf("Expected collection-level document to contain %s", expectedField)
+		}
+		for _, component := range *eadToTest.Components {
+			if !strings.Contains(component.SolrAddMessage.String(), expectedField) {
+				t.Errorf("Expected component-level document %s to contain %s", component.ID, expectedField)
+			}
+		}
+	}
+
+	// Empty values are left out.
+	if strings.Contains(eadToTest.CollectionDoc.SolrAddMessage.String(), "source_commit_ssi") {
+		t.Errorf("Expected no source_commit_ssi field for an empty source commit")
+	}
+}
+
+// fales/mss_580_ead3 is a translation of fales/mss_580 into EAD3, so apart from
+// the EAD ID the Solr add messages for the two should be identical.
+func TestEAD3SolrAddMessages(t *testing.T) {
+	ead2002, err := newTestEAD(filepath.Join("fales", "mss_580"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ead3, err := newTestEAD(filepath.Join("fales", "mss_580_ead3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ead2002.EADVersion != eadutil.EADVersion2002 {
+		t.Errorf(`Expected EAD version "%s", got "%s"`, eadutil.EADVersion2002, ead2002.EADVersion)
+	}
+	if ead3.EADVersion != eadutil.EADVersion3 {
+		t.Errorf(`Expected EAD version "%s", got "%s"`, eadutil.EADVersion3, ead3.EADVersion)
+	}
+
+	expected := []string{ead2002.CollectionDoc.SolrAddMessage.String()}
+	for _, component := range *ead2002.Components {
+		expected = append(expected, component.SolrAddMessage.String())
+	}
+
+	actual := []string{ead3.CollectionDoc.SolrAddMessage.String()}
+	for _, component := range *ead3.Components {
+		actual = append(actual, component.SolrAddMessage.String())
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d Solr add messages, got %d", len(expected), len(actual))
+	}
+
+	for i := range expected {
+		actualWithEAD2002EADID := strings.ReplaceAll(actual[i], "mss_580_ead3", "mss_580")
+		if actualWithEAD2002EADID != expected[i] {
+			diff := util.DiffStrings("expected", expected[i], "actual", actualWithEAD2002EADID)
+			t.Errorf("EAD3 Solr add message %d does not match EAD 2002 Solr add message: %s", i, diff)
+		}
+	}
+}
+
+// EAD3 markup that has no counterpart in fales/mss_580.
+func TestEAD3StructuredMarkup(t *testing.T) {
+	const eadXML = `<?xml version="1.0" encoding="utf-8"?>
+<ead xmlns="http://ead3.archivists.org/schema/">
+  <control><recordid>mss_999</recordid><filedesc><titlestmt><titleproper>Guide to the Test Papers</titleproper></titlestmt></filedesc></control>
+  <archdesc level="collection">
+    <did>
+      <unittitle>Test Papers</unittitle>
+      <unitid>MSS.999</unitid>
+      <unitid localtype="aspace_uri">/repositories/2/resources/999</unitid>
+      <unitdatestructured unitdatetype="bulk"><datesingle standarddate="1985">1985</datesingle></unitdatestructured>
+      <langmaterial>
+        <languageset><language langcode="rus">Russian</language><script scriptcode="Cyrl">Cyrillic</script></languageset>
+        <languageset><language langcode="ger">German</language><script scriptcode="Latf">Fraktur</script></languageset>
+      </langmaterial>
+    </did>
+    <relations>
+      <relation relationtype="cpfrelation"><relationentry>Smith, Jane</relationentry></relation>
+      <relation relationtype="functionrelation"><relationentry>Bookselling</relationentry></relation>
+    </relations>
+    <dsc>
+      <c id="c1" level="file">
+        <did>
+          <unittitle>Photographs</unittitle>
+          <daoset label="Photographs">
+            <dao daotype="derived" href="https://example.com/1.jpg" linkrole="image-thumbnail" show="embed" actuate="onload"/>
+            <dao daotype="derived" href="https://example.com/1.tif" linkrole="image-service" show="new" actuate="actuatenone"/>
+            <descriptivenote><p>Photograph scans</p></descriptivenote>
+          </daoset>
+        </did>
+      </c>
+    </dsc>
+  </archdesc>
+</ead>`
+
+	eadToTest, err := New("fales", eadXML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collectionDocSolrAddMessage := eadToTest.CollectionDoc.SolrAddMessage.String()
+	for _, expectedField := range []string{
+		`<field name="id">mss_999</field>`,
+		`<field name="unitid_ssm">MSS.999</field>`,
+		`<field name="unitdate_bulk_teim">1985</field>`,
+		`<field name="unitdate_normal_ssm">1985</field>`,
+		`<field name="language_ssm">Russian</field>`,
+		`<field name="language_ssm">German</field>`,
+		`<field name="script_ssm">Cyrillic</field>`,
+		`<field name="script_ssm">Latin (Fraktur variant)</field>`,
+		`<field name="name_teim">Smith, Jane</field>`,
+		`<field name="function_teim">Bookselling</field>`,
+	} {
+		if !strings.Contains(collectionDocSolrAddMessage, expectedField) {
+			t.Errorf("Expected collection-level document to contain %s", expectedField)
+		}
+	}
+	if strings.Contains(collectionDocSolrAddMessage, "/repositories/2/resources/999") {
+		t.Errorf("Expected collection-level document to not contain the ArchivesSpace URI <unitid>")
+	}
+
+	componentSolrAddMessage := (*eadToTest.Components)[0].SolrAddMessage.String()
+	for _, expectedField := range []string{
+		`<field name="id">mss_999c1</field>`,
+		`<field name="dao_sim">Online Access</field>`,
+		`<field name="dao_role_sim">image-thumbnail</field>`,
+		`<field name="dao_role_sim">image-service</field>`,
+		`&#34;actuate&#34;:&#34;none&#34;`,
+	} {
+		if !strings.Contains(componentSolrAddMessage, expectedField) {
+			t.Errorf("Expected component-level document to contain %s", expectedField)
+		}
+	}
+}
+
 func TestNewWithBadEADXML(t *testing.T) {
 	testCases := []struct {
 		eadXML        string
@@ -178,6 +381,50 @@ func TestNewWithInvalidRepositoryCode(t *testing.T) {
 	}
 }
 
+func TestNewWithOptions_DateRangeFacets(t *testing.T) {
+	testEAD := filepath.Join("fales", "mss_460")
+	eadXML, err := testutils.GetEADFixtureValue(testEAD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eadToTest, err := NewWithOptions(testutils.ParseRepositoryCode(testEAD), eadXML, Options{
+		DateRangeFacets: []eadutil.DateRangeFacet{
+			{
+				FieldName:  "decade_sim",
+				DateRanges: eadutil.MakeDateRanges(2000, 2029, 10),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	collectionDocSolrAddMessage := eadToTest.CollectionDoc.SolrAddMessage.String()
+	if !strings.Contains(collectionDocSolrAddMessage, `<field name="decade_sim">2010-2019</field>`) {
+		t.Errorf("Expected collection-level document to contain the configured date range facet")
+	}
+	if strings.Contains(collectionDocSolrAddMessage, eadutil.DefaultDateRangeFacetFieldName) {
+		t.Errorf("Expected collection-level document to not contain the default date range facet")
+	}
+
+	_, err = NewWithOptions(testutils.ParseRepositoryCode(testEAD), eadXML, Options{
+		DateRangeFacets: []eadutil.DateRangeFacet{},
+	})
+	if err == nil || err.Error() != "no date range facets" {
+		t.Errorf(`Expected error "no date range facets", got: %v`, err)
+	}
+}
+
+func newTestEAD(testEAD string) (EAD, error) {
+	eadXML, err := testutils.GetEADFixtureValue(testEAD)
+	if err != nil {
+		return EAD{}, err
+	}
+
+	return New(testutils.ParseRepositoryCode(testEAD), eadXML)
+}
+
 func clean() error {
 	err := os.RemoveAll(tmpFilesDirPath)
 	if err != nil {
@@ -207,6 +454,54 @@ func testComponentSolrAddMessage(testEAD string, fileID string,
 	testSolrAddMessageXML(testEAD, fileID, fmt.Sprintf("%s", solrAddMessage), t)
 }
 
+func testSolrAddMessageJSON(fileID string, solrAddMessageXML string,
+	solrAddMessageJSON string, t *testing.T) {
+	var xmlMessage struct {
+		Fields []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"doc>field"`
+	}
+	err := xml.Unmarshal([]byte(solrAddMessageXML), &xmlMessage)
+	if err != nil {
+		t.Fatalf("Error unmarshaling XML for \"%s\": %s", fileID, err)
+	}
+
+	expectedFields := map[string][]string{}
+	for _, field := range xmlMessage.Fields {
+		expectedFields[field.Name] = append(expectedFields[field.Name], field.Value)
+	}
+
+	var jsonMessage struct {
+		Add struct {
+			Doc map[string]any `json:"doc"`
+		} `json:"add"`
+	}
+	err = json.Unmarshal([]byte(solrAddMessageJSON), &jsonMessage)
+	if err != nil {
+		t.Fatalf("Error unmarshaling JSON for \"%s\": %s", fileID, err)
+	}
+
+	actualFields := map[string][]string{}
+	for fieldName, fieldValue := range jsonMessage.Add.Doc {
+		switch typedFieldValue := fieldValue.(type) {
+		case string:
+			actualFields[fieldName] = []string{typedFieldValue}
+		case []any:
+			for _, value := range typedFieldValue {
+				actualFields[fieldName] = append(actualFields[fieldName], value.(string))
+			}
+		default:
+			t.Fatalf("Unexpected type %T for field %s of \"%s\"", fieldValue, fieldName, fileID)
+		}
+	}
+
+	if !reflect.DeepEqual(actualFields, expectedFields) {
+		t.Errorf("JSON fields for \"%s\" do not match XML fields:\nXML: %v\nJSON: %v",
+			fileID, expectedFields, actualFields)
+	}
+}
+
 func testNoMissingComponents(testEAD string, componentIDs []string, t *testing.T) {
 	missingComponents := []string{}
 
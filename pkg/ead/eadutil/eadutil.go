@@ -1,6 +1,8 @@
 package eadutil
 
 import (
+	"bytes"
+	"encoding/json"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -13,25 +15,94 @@ import (
 	"html"
 	"io"
 	"maps"
+	"math"
+	"os"
 	"path/filepath"
 	"reflect"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// DateParts holds the start and end of an EAD @normal date.  `Start` and
+// `End` are years; `StartDate` and `EndDate` are the full dates in ISO 8601
+// extended format at the precision given, e.g. "1950", "1950-03" or
+// "1950-03-01".  Either side is empty for an open or unknown end of a range.
 type DateParts struct {
-	Start string
-	End   string
+	Start     string
+	End       string
+	StartDate string
+	EndDate   string
 }
 
+// DateRange is a date range facet bucket.  `StartDate` and `EndDate` are
+// inclusive years.
 type DateRange struct {
-	Display   string
-	StartDate int
-	EndDate   int
+	Display   string `json:"display"`
+	StartDate int    `json:"start"`
+	EndDate   int    `json:"end"`
 }
 
+// DateRangeFacet is a Solr facet field and the buckets that dates are mapped
+// to for it.
+type DateRangeFacet struct {
+	FieldName  string
+	DateRanges []DateRange
+}
+
+// DateRangeFacetConfig is an entry in a date range facets configuration file.
+// Buckets can be listed in `Ranges`, generated every `Interval` years from
+// `Start` through `End`, or both.
+type DateRangeFacetConfig struct {
+	Field    string      `json:"field"`
+	Ranges   []DateRange `json:"ranges"`
+	Interval int         `json:"interval"`
+	Start    int         `json:"start"`
+	End      int         `json:"end"`
+}
+
+// Options configure how the collection-level and component-level documents are
+// made from an EAD file.  The zero value gives the default mapping.
+type Options struct {
+	// The date range facet fields that unit dates are mapped to.  If nil,
+	// `DefaultDateRangeFacets()` is used.
+	DateRangeFacets []DateRangeFacet
+	// The language code tables used to look up languages.  If empty,
+	// `language.ModeV1` is used.
+	LanguageMode languageLib.Mode
+}
+
+// ead3Date is a <datesingle> or <daterange> from an EAD3 <unitdatestructured>.
+type ead3Date struct {
+	display string
+	normal  string
+}
+
+// ExtentQuantity is the total quantity of a normalized extent unit.
+type ExtentQuantity struct {
+	Quantity string
+	Unit     string
+}
+
+// DigitalObject is a link from a <dao> or <daoloc> element, built from its
+// xlink attributes.
+type DigitalObject struct {
+	Actuate string `json:"actuate,omitempty"`
+	Href    string `json:"href,omitempty"`
+	Role    string `json:"role,omitempty"`
+	Title   string `json:"title,omitempty"`
+}
+
+// EADVersion is the version of the EAD standard that an EAD file is encoded in.
+type EADVersion string
+
+const (
+	EADVersion2002 EADVersion = "EAD 2002"
+	EADVersion3    EADVersion = "EAD3"
+)
+
 type DocElementField struct {
 	FieldName string
 	Field     reflect.Value
@@ -41,25 +112,72 @@ const eadLineBreakTag = "<lb/>"
 
 const undated = "undated & other"
 
+// Normalized units for parsed <extent> quantities, in the order in which
+// `GetExtentQuantities()` returns them.
+const ExtentUnitLinearFeet = "linear feet"
+const ExtentUnitBoxes = "boxes"
+const ExtentUnitItems = "items"
+const ExtentUnitGB = "GB"
+
+// Field for the date range facet if no others are configured.
+const DefaultDateRangeFacetFieldName = "date_range_sim"
+
+// Values of the "restricted" facet.
+const Restricted = "Restricted"
+const Unrestricted = "Unrestricted"
+
 var allowedConvertedEADToHTMLTags = util.CompactStringSlicePreserveOrder(
 	slices.Collect(maps.Values(eadTagRenderAttributeToHTMLTagName)))
 
-var datePartsRegexp = regexp.MustCompile(`^\s*(\d{4})\/(\d{4})\s*$`)
+// A single ISO 8601 date: YYYY, YYYY-MM or YYYY-MM-DD, or the basic format
+// YYYYMMDD.  ISO 8601 has no basic format for a year and month, which would be
+// confused with YYMMDD.  EDTF unspecified digits ("195X", "19XX") are allowed
+// in the last two digits of a year that has no month.
+var isoDateExtendedRegexp = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?)?$`)
+var isoDateBasicRegexp = regexp.MustCompile(`^(\d{4})(\d{2})(\d{2})$`)
+var isoDateUnspecifiedYearRegexp = regexp.MustCompile(`^\d{2}(?:\d[Xxu]|[Xxu]{2})$`)
+
+// EDTF uncertain ("?"), approximate ("~") and uncertain-and-approximate ("%")
+// qualifiers.  We index the date as if unqualified.
+var edtfQualifierRegexp = regexp.MustCompile(`[?~%]`)
 
 // We assume lowercase entity references only because Go escaping uses lowercase.
 var multipleEscapedAmpersandRegexp = regexp.MustCompile(`(?:&amp;)+amp;`)
 
-var dateRangesCenturies = []DateRange{
-	{Display: "1101-1200", StartDate: 1101, EndDate: 1200},
-	{Display: "1201-1300", StartDate: 1201, EndDate: 1300},
-	{Display: "1301-1400", StartDate: 1301, EndDate: 1400},
-	{Display: "1401-1500", StartDate: 1401, EndDate: 1500},
-	{Display: "1501-1600", StartDate: 1501, EndDate: 1600},
-	{Display: "1601-1700", StartDate: 1601, EndDate: 1700},
-	{Display: "1701-1800", StartDate: 1701, EndDate: 1800},
-	{Display: "1801-1900", StartDate: 1801, EndDate: 1900},
-	{Display: "1901-2000", StartDate: 1901, EndDate: 2000},
-	{Display: "2001-2100", StartDate: 2001, EndDate: 2100},
+// Matches a quantity followed by a unit, allowing for up to two qualifying
+// words in between, as in "2 manuscript boxes" or "1 half manuscript box".
+// Only the qualifiers listed are allowed, so that the unit is the thing being
+// counted: "2 folders containing items" is not 2 items.  Leading text like
+// "in", "for" or "(" is skipped.  A "half" among the qualifying words halves
+// the quantity.
+var extentQuantityRegexp = regexp.MustCompile(
+	`(?i)(\d[\d,]*(?:\.\d+)?|\.\d+|\bone\b)\s+` +
+		`((?:(?:archival|artifact|card|cassette|cd|digital|document|file|flat|` +
+		`flat-file|half|hollinger|individual|large|legal|letter|manuscript|media|` +
+		`oversized?|record|small|tape)\s+){0,2})` +
+		`(linear\s+f(?:ee|oo)?t\.?|lin\.?\s+ft\.?|box(?:es)?|items?|` +
+		`terabytes?|tb|gigabytes?|gb|megabytes?|mb|kilobytes?|kb)\b`)
+
+// Text between two quantities of the same unit in an <extent> statement that
+// shows the later one breaks down or restates the earlier one rather than
+// adding to it, as in "75 boxes: 32 manuscript boxes, ..." or "13 boxes (12
+// record cartons, 1 flat box)".
+var extentBreakdownRegexp = regexp.MustCompile(`[:(\n]|\.\s`)
+
+// Normalized unit and multiplier for each unit in `extentQuantityRegexp`,
+// keyed by the first letters of the lowercased unit.
+var extentUnitConversions = []struct {
+	prefix     string
+	unit       string
+	multiplier float64
+}{
+	{"lin", ExtentUnitLinearFeet, 1},
+	{"box", ExtentUnitBoxes, 1},
+	{"item", ExtentUnitItems, 1},
+	{"t", ExtentUnitGB, 1000},
+	{"g", ExtentUnitGB, 1},
+	{"m", ExtentUnitGB, 0.001},
+	{"k", ExtentUnitGB, 0.000001},
 }
 
 var eadTagRenderAttributeToHTMLTagName = map[string]string{
@@ -94,6 +212,17 @@ var trailingWhitespaceInFieldContent = regexp.MustCompile(`[\s ]+$`)
 var closeTagRegExp = regexp.MustCompile("</[^>]+>$")
 var openTagRegExp = regexp.MustCompile("^<[^>]+>")
 
+// Words in access restriction notes that mean some of the material can't be
+// freely used.  Statements that there are no restrictions are removed before
+// matching.
+var restrictedAccessRegexp = regexp.MustCompile(
+	`(?i)\b(restrict(ed|ion|ions|s)?|closed|unavailable|not\s+(be\s+|yet\s+)?available|embargo(ed)?|sealed)\b`)
+
+// A negator followed by a restriction word cancels it, e.g. "not restricted",
+// "no known restrictions", "without any access restrictions".
+var noRestrictionsRegexp = regexp.MustCompile(
+	`(?i)\b(no|not|without)\s+((known|any|access|be|subject\s+to)\s+)*restrict(ed|ion|ions|s)?\b`)
+
 var validEADIDRegexp = regexp.MustCompile(validate.ValidEADIDRegexpString)
 
 func ConvertEADToHTML(eadString string) (string, error) {
@@ -110,6 +239,17 @@ func ConvertToFacetSlice(rawSlice []string) []string {
 		replaceMARCSubfieldDemarcatorsInSlice(rawSlice))
 }
 
+// DefaultDateRangeFacets returns the date range facets used unless others are
+// set: centuries from 1101 through 2100 in "date_range_sim".
+func DefaultDateRangeFacets() []DateRangeFacet {
+	return []DateRangeFacet{
+		{
+			FieldName:  DefaultDateRangeFacetFieldName,
+			DateRanges: MakeDateRanges(1101, 2100, 100),
+		},
+	}
+}
+
 func EADPathToEADID(path string) (string, error) {
 	eadID := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
 	if !IsValidEADID(eadID) {
@@ -118,28 +258,71 @@ func EADPathToEADID(path string) (string, error) {
 	return eadID, nil
 }
 
+// GetDateParts parses an EAD @normal date, which is an ISO 8601 date or range
+// of dates separated by "/".  Ranges can be open ("1950/..") or have an
+// unknown end ("1950/"), and dates can use the EDTF qualifiers and unspecified
+// digits described above.  A single date is treated as a range from its
+// earliest to its latest reading, e.g. "195X" is 1950 to 1959.  Returns empty
+// `DateParts` if the string can't be parsed or the range ends before it starts.
 func GetDateParts(dateString string) DateParts {
+	dateString = strings.TrimSpace(dateString)
+
+	var startString, endString string
+	switch rangeParts := strings.Split(dateString, "/"); len(rangeParts) {
+	case 1:
+		startString, endString = rangeParts[0], rangeParts[0]
+	case 2:
+		startString, endString = rangeParts[0], rangeParts[1]
+	default:
+		return DateParts{}
+	}
+
 	dateParts := DateParts{}
+	if !isOpenRangeEnd(startString) {
+		startDate, _, ok := parseISODate(startString)
+		if !ok {
+			return DateParts{}
+		}
+		dateParts.Start = startDate[:4]
+		dateParts.StartDate = startDate
+	}
 
-	matches := datePartsRegexp.FindStringSubmatch(dateString)
+	if !isOpenRangeEnd(endString) {
+		_, endDate, ok := parseISODate(endString)
+		if !ok {
+			return DateParts{}
+		}
+		dateParts.End = endDate[:4]
+		dateParts.EndDate = endDate
+	}
 
-	if len(matches) == 3 {
-		dateParts.Start = matches[1]
-		dateParts.End = matches[2]
+	if dateParts.Start == "" && dateParts.End == "" {
+		return DateParts{}
+	}
+
+	// Compare at the precision of the less precise end, so that "1950-03/1950"
+	// is valid but "1950-12/1950-03" is not.
+	if dateParts.StartDate != "" && dateParts.EndDate != "" {
+		precision := min(len(dateParts.StartDate), len(dateParts.EndDate))
+		if dateParts.StartDate[:precision] > dateParts.EndDate[:precision] {
+			return DateParts{}
+		}
 	}
 
 	return dateParts
 }
 
-func GetDateRange(unitDates []string) []string {
+// GetDateRange returns the display values of the `dateRanges` that
+// `unitDates` fall in, or "undated & other" if any of them doesn't fall in one.
+func GetDateRange(unitDates []string, dateRanges []DateRange) []string {
 	dateRange := []string{}
 
-	// Add `dateRangeCentury` display dates for which at least one date falls within
+	// Add `dateRanges` display dates for which at least one date falls within
 	// range.
-	for _, dateRangeCentury := range dateRangesCenturies {
+	for _, bucket := range dateRanges {
 		for _, unitDate := range unitDates {
-			if isDateInRange(unitDate, dateRangeCentury) {
-				dateRange = append(dateRange, dateRangeCentury.Display)
+			if isDateInRange(unitDate, bucket) {
+				dateRange = append(dateRange, bucket.Display)
 				break
 			}
 		}
@@ -149,8 +332,8 @@ func GetDateRange(unitDates []string) []string {
 	existsDateWithRangeNotFound := false
 	for _, unitDate := range unitDates {
 		matchFound := false
-		for _, dateRangeCentury := range dateRangesCenturies {
-			if isDateInRange(unitDate, dateRangeCentury) {
+		for _, bucket := range dateRanges {
+			if isDateInRange(unitDate, bucket) {
 				matchFound = true
 				break
 			}
@@ -170,6 +353,21 @@ func GetDateRange(unitDates []string) []string {
 	return dateRange
 }
 
+// GetDateRangeFacetValues returns the `GetDateRange()` values of `unitDates`
+// for each of `dateRangeFacets`, keyed by field name.
+func GetDateRangeFacetValues(unitDates []string, dateRangeFacets []DateRangeFacet) map[string][]string {
+	dateRangeFacetValues := map[string][]string{}
+	for _, dateRangeFacet := range dateRangeFacets {
+		dateRangeFacetValues[dateRangeFacet.FieldName] =
+			GetDateRange(unitDates, dateRangeFacet.DateRanges)
+	}
+
+	return dateRangeFacetValues
+}
+
+// GetDocElementFieldsInAlphabeticalOrder returns the fields of a `DocElement`
+// struct, named by their "xml" struct tags.  Fields that are maps of field name
+// to values, like the date range facets, are expanded into a field per key.
 func GetDocElementFieldsInAlphabeticalOrder(docElement any) []DocElementField {
 	docElementFields := []DocElementField{}
 
@@ -179,6 +377,18 @@ func GetDocElementFieldsInAlphabeticalOrder(docElement any) []DocElementField {
 	numFields := docElementStructValue.NumField()
 	for i := 0; i < numFields; i++ {
 		field := docElementStructValue.Field(i)
+		if field.Kind() == reflect.Map {
+			mapIterator := field.MapRange()
+			for mapIterator.Next() {
+				docElementFields = append(docElementFields, DocElementField{
+					FieldName: mapIterator.Key().String(),
+					Field:     mapIterator.Value(),
+				})
+			}
+
+			continue
+		}
+
 		fieldName := strings.Split(docElementStructType.Field(i).Tag.Get("xml"), ",")[0]
 		docElementField := DocElementField{
 			FieldName: fieldName,
@@ -194,6 +404,216 @@ func GetDocElementFieldsInAlphabeticalOrder(docElement any) []DocElementField {
 	return docElementFields
 }
 
+// GetDigitalObjects returns a `DigitalObject` for each <dao> or <daoloc> XML
+// string.  Attributes are matched on local name only, so the "xlink" prefix
+// does not need to be declared in the string.  EAD3 <dao> attributes, which
+// have no prefix, are mapped to their EAD 2002 equivalents.
+func GetDigitalObjects(daoXMLStrings []string) ([]DigitalObject, error) {
+	digitalObjects := []DigitalObject{}
+	for _, daoXMLString := range daoXMLStrings {
+		token, err := xml.NewDecoder(strings.NewReader(daoXMLString)).Token()
+		if err != nil {
+			return digitalObjects, err
+		}
+
+		startElement, ok := token.(xml.StartElement)
+		if !ok {
+			return digitalObjects, fmt.Errorf("not a <dao> or <daoloc> element: %s", daoXMLString)
+		}
+
+		digitalObject := DigitalObject{}
+		for _, attribute := range startElement.Attr {
+			value := strings.TrimSpace(attribute.Value)
+			switch attribute.Name.Local {
+			case "actuate":
+				digitalObject.Actuate = normalizeEAD3ActuateValue(value)
+			case "href":
+				digitalObject.Href = value
+			case "linkrole", "role":
+				digitalObject.Role = value
+			case "linktitle", "title":
+				digitalObject.Title = value
+			}
+		}
+
+		digitalObjects = append(digitalObjects, digitalObject)
+	}
+
+	return digitalObjects, nil
+}
+
+// GetEAD3NodeValuesAndXMLStrings is `GetNodeValuesAndXMLStrings()` for EAD3
+// queries that can match the structured elements that replaced EAD 2002 text.
+// <unitdatestructured> values are rendered like EAD 2002 <unitdate> text, e.g.
+// "1977-1980", and <physdescstructured> values like EAD 2002 <extent> text,
+// e.g. "2.5 linear feet".  All other nodes are handled the same way as in
+// `GetNodeValuesAndXMLStrings()`.
+func GetEAD3NodeValuesAndXMLStrings(query string, node types.Node) ([]string, []string, error) {
+	var values []string
+	var xmlStrings []string
+
+	nodeList, err := GetNodeList(query, node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, resultNode := range nodeList {
+		var value string
+		switch resultNode.NodeName() {
+		case "physdescstructured":
+			value, err = getEAD3PhysDescStructuredValue(resultNode)
+		case "unitdatestructured":
+			var ead3Dates []ead3Date
+			ead3Dates, err = getEAD3Dates(resultNode)
+			displays := []string{}
+			for _, ead3Date := range ead3Dates {
+				displays = append(displays, ead3Date.display)
+			}
+			value = strings.Join(displays, ", ")
+		default:
+			if resultNode.NodeType() == dom.ElementNode {
+				value, err = parseNodeValue(resultNode.String())
+			} else {
+				value = resultNode.NodeValue()
+			}
+		}
+		if err != nil {
+			return values, xmlStrings, err
+		}
+
+		values = append(values, value)
+		xmlStrings = append(xmlStrings, resultNode.String())
+	}
+
+	return values, xmlStrings, nil
+}
+
+// GetEAD3UnitDateNormalValuesAndXMLStrings returns EAD 2002 style @normal
+// values for the <unitdate> @normal attributes and <unitdatestructured>
+// elements matched by `query`.  Each <datesingle> and <daterange> in a
+// <unitdatestructured> gets its own value, made from @standarddate: e.g.
+// "1980" or "1977/1980", with ".." for a range end that has no @standarddate.
+// Dates without any @standarddate are skipped.
+func GetEAD3UnitDateNormalValuesAndXMLStrings(query string, node types.Node) ([]string, []string, error) {
+	var values []string
+	var xmlStrings []string
+
+	nodeList, err := GetNodeList(query, node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, resultNode := range nodeList {
+		if resultNode.NodeName() != "unitdatestructured" {
+			values = append(values, resultNode.NodeValue())
+			xmlStrings = append(xmlStrings, resultNode.String())
+			continue
+		}
+
+		ead3Dates, err := getEAD3Dates(resultNode)
+		if err != nil {
+			return values, xmlStrings, err
+		}
+
+		for _, ead3Date := range ead3Dates {
+			if ead3Date.normal == "" {
+				continue
+			}
+			values = append(values, ead3Date.normal)
+			xmlStrings = append(xmlStrings, resultNode.String())
+		}
+	}
+
+	return values, xmlStrings, nil
+}
+
+// GetEADVersion returns the version of the EAD standard that the document
+// containing `node` is encoded in.  EAD3 replaced EAD 2002's <eadheader> with
+// <control>.
+func GetEADVersion(node types.Node) (EADVersion, error) {
+	controlNode, err := GetFirstNode("/ead/control", node)
+	if err != nil {
+		return "", err
+	}
+
+	if controlNode != nil {
+		return EADVersion3, nil
+	}
+
+	return EADVersion2002, nil
+}
+
+// GetExtentQuantities parses <extent> statements and returns the total
+// quantity for each normalized unit found.  Every quantity in a statement is
+// counted, so "2 linear feet (4 boxes)" gives both 2 linear feet and 4 boxes,
+// except that quantities after a ":", "(" or sentence break are ignored if the
+// statement already counted that unit: see `extentBreakdownRegexp`.
+// Quantities with units we don't normalize (e.g. "12 Photographic Prints") are
+// ignored.  Storage sizes are converted to GB using decimal multiples.
+func GetExtentQuantities(extents []string) []ExtentQuantity {
+	totals := map[string]float64{}
+	for _, extent := range extents {
+		// End of the last quantity counted for each unit in this statement.
+		lastCountedEnds := map[string]int{}
+		breakdownUnits := map[string]bool{}
+		for _, matchIndexes := range extentQuantityRegexp.FindAllStringIndex(extent, -1) {
+			match := extentQuantityRegexp.FindStringSubmatch(extent[matchIndexes[0]:matchIndexes[1]])
+
+			var quantity float64
+			if strings.EqualFold(match[1], "one") {
+				quantity = 1
+			} else {
+				var err error
+				quantity, err = strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+				if err != nil {
+					continue
+				}
+			}
+
+			for _, word := range strings.Fields(match[2]) {
+				if strings.EqualFold(word, "half") {
+					quantity /= 2
+					break
+				}
+			}
+
+			unit := strings.ToLower(match[3])
+			for _, conversion := range extentUnitConversions {
+				if !strings.HasPrefix(unit, conversion.prefix) {
+					continue
+				}
+
+				lastCountedEnd, ok := lastCountedEnds[conversion.unit]
+				if ok && extentBreakdownRegexp.MatchString(extent[lastCountedEnd:matchIndexes[0]]) {
+					breakdownUnits[conversion.unit] = true
+				}
+				if !breakdownUnits[conversion.unit] {
+					totals[conversion.unit] += quantity * conversion.multiplier
+					lastCountedEnds[conversion.unit] = matchIndexes[1]
+				}
+				break
+			}
+		}
+	}
+
+	extentQuantities := []ExtentQuantity{}
+	for _, unit := range []string{ExtentUnitLinearFeet, ExtentUnitBoxes, ExtentUnitItems, ExtentUnitGB} {
+		total, ok := totals[unit]
+		if !ok {
+			continue
+		}
+
+		// Round off floating point noise from summing and unit conversion.
+		total = math.Round(total*1000000) / 1000000
+		extentQuantities = append(extentQuantities, ExtentQuantity{
+			Quantity: strconv.FormatFloat(total, 'f', -1, 64),
+			Unit:     unit,
+		})
+	}
+
+	return extentQuantities
+}
+
 func GetFirstNode(query string, node types.Node) (types.Node, error) {
 	xpathResult, err := node.Find(query)
 	if err != nil {
@@ -210,12 +630,14 @@ func GetFirstNode(query string, node types.Node) (types.Node, error) {
 	}
 }
 
-func GetLanguage(langCodes []string) ([]string, []error) {
+// GetLanguage returns the language names for <language>/@langcode values,
+// looked up in the language code tables for `mode`.
+func GetLanguage(langCodes []string, mode languageLib.Mode) ([]string, []error) {
 	language := []string{}
 	errs := []error{}
 
 	for _, langCode := range langCodes {
-		languageForLangCode, err := languageLib.GetLanguageForLanguageCode(langCode)
+		languageForLangCode, err := mode.GetLanguageForLanguageCode(langCode)
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -226,6 +648,21 @@ func GetLanguage(langCodes []string) ([]string, []error) {
 	return language, errs
 }
 
+// GetLanguagesInNotes returns the languages named in free-text <langmaterial>
+// notes, e.g. "The documents in the collection are in English.", using the
+// language names for `mode`.  The results are not de-duplicated against each
+// other or against the languages from `GetLanguage()`: callers append them to
+// the coded languages, and the combined values are compacted with
+// `util.CompactStringSlicePreserveOrder()` when the Solr add message is built.
+func GetLanguagesInNotes(langMaterialNotes []string, mode languageLib.Mode) []string {
+	languages := []string{}
+	for _, langMaterialNote := range langMaterialNotes {
+		languages = append(languages, mode.GetLanguagesInText(langMaterialNote)...)
+	}
+
+	return languages
+}
+
 func GetNodeList(query string, node types.Node) (types.NodeList, error) {
 	xpathResult, err := node.Find(query)
 	if err != nil {
@@ -236,6 +673,93 @@ func GetNodeList(query string, node types.Node) (types.NodeList, error) {
 	return xpathResult.NodeList(), nil
 }
 
+// LoadDateRangeFacets reads date range facets from a JSON configuration file
+// containing an array of `DateRangeFacetConfig` objects.  For example, this
+// configures centuries from 1 through 2100 and decades from 1900 through 2029,
+// plus a custom bucket:
+//
+//	[
+//	  {"field": "date_range_sim", "interval": 100, "start": 1, "end": 2100},
+//	  {"field": "decade_sim", "interval": 10, "start": 1900, "end": 2029,
+//	   "ranges": [{"display": "Before 1900", "start": 0, "end": 1899}]}
+//	]
+func LoadDateRangeFacets(path string) ([]DateRangeFacet, error) {
+	configBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []DateRangeFacetConfig
+	err = json.Unmarshal(configBytes, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse date range facets configuration %s: %s", path, err)
+	}
+
+	dateRangeFacets := []DateRangeFacet{}
+	for _, config := range configs {
+		if config.Interval < 0 {
+			return nil, fmt.Errorf(`date range facet "%s": interval must not be negative`, config.Field)
+		}
+
+		dateRanges := slices.Clone(config.Ranges)
+		if config.Interval > 0 {
+			if config.End < config.Start {
+				return nil, fmt.Errorf(`date range facet "%s": end %d is before start %d`,
+					config.Field, config.End, config.Start)
+			}
+			dateRanges = append(dateRanges, MakeDateRanges(config.Start, config.End, config.Interval)...)
+		}
+
+		dateRangeFacets = append(dateRangeFacets, DateRangeFacet{
+			FieldName:  config.Field,
+			DateRanges: dateRanges,
+		})
+	}
+
+	err = ValidateDateRangeFacets(dateRangeFacets)
+	if err != nil {
+		return nil, err
+	}
+
+	return dateRangeFacets, nil
+}
+
+// GetRestricted returns the "restricted" facet value for access restriction
+// notes, or "" if there are none.
+func GetRestricted(accessRestrict []string) string {
+	if len(accessRestrict) == 0 {
+		return ""
+	}
+
+	for _, accessRestrictValue := range accessRestrict {
+		if restrictedAccessRegexp.MatchString(
+			noRestrictionsRegexp.ReplaceAllString(accessRestrictValue, "")) {
+			return Restricted
+		}
+	}
+
+	return Unrestricted
+}
+
+// GetScript returns the script names for <language>/@scriptcode values.  Our
+// ISO 15924 table is only a subset of the standard, so unknown codes are passed
+// through as-is rather than failing the whole EAD file.
+func GetScript(scriptCodes []string) []string {
+	script := []string{}
+	for _, scriptCode := range scriptCodes {
+		scriptForScriptCode, err := languageLib.GetScriptForScriptCode(scriptCode)
+		if err != nil {
+			if errors.Is(err, languageLib.ErrScriptNotFound) {
+				script = append(script, strings.TrimSpace(scriptCode))
+			}
+			continue
+		}
+		script = append(script, scriptForScriptCode)
+	}
+
+	return script
+}
+
 func GetUnitDateDisplay(unitDateNoTypeAttribute []string, unitDateInclusive []string,
 	unitDateBulk []string) string {
 	partsUnitDateDisplay := []string{}
@@ -347,6 +871,148 @@ func MakeSolrAddMessageFieldElementStrings(docElementFields []DocElementField) [
 	return fieldElementStrings
 }
 
+// MakeSolrAddMessageJSON makes a Solr JSON update message that adds the same
+// document as the XML message made from `docElementFields`.  Slice fields
+// become JSON arrays, even if they only have one value.  Field values are
+// sent exactly as they are, so unlike in XML messages, there is no escaping
+// to get right.
+func MakeSolrAddMessageJSON(docElementFields []DocElementField) string {
+	doc := map[string]any{}
+	for _, docElementField := range docElementFields {
+		field := docElementField.Field
+		fieldName := docElementField.FieldName
+		fieldTypeKind := field.Type().Kind()
+		if fieldTypeKind == reflect.Slice {
+			fieldValues := []string{}
+			for _, fieldValue := range field.Interface().([]string) {
+				if util.IsNonEmptyString(fieldValue) {
+					fieldValues = append(fieldValues, fieldValue)
+				}
+			}
+			if len(fieldValues) > 0 {
+				doc[fieldName] = fieldValues
+			}
+		} else if fieldTypeKind == reflect.String {
+			fieldValue := field.String()
+			if util.IsNonEmptyString(fieldValue) {
+				doc[fieldName] = fieldValue
+			}
+		} else {
+			// Should never get here!  See `MakeSolrAddMessageFieldElementStrings()`.
+			panic("Unrecognized `reflect.Type.Kind`: " + fieldTypeKind.String())
+		}
+	}
+
+	// `encoding/json` sorts map keys, so the fields are in alphabetical order,
+	// as in the XML messages.
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(map[string]any{
+		"add": map[string]any{
+			"doc": doc,
+		},
+	})
+	if err != nil {
+		// Should never get here, as only strings and string slices are encoded.
+		panic("json.Encoder.Encode() failed with error: " + err.Error())
+	}
+
+	return buffer.String()
+}
+
+// MakeDateRangeFieldValue returns `dateParts` as a Solr DateRangeField value,
+// e.g. "[1950 TO 1975]", "[1950-03-01 TO *]" for an open range, or "1950-03"
+// for a single date.  Returns "" for empty `DateParts`.
+func MakeDateRangeFieldValue(dateParts DateParts) string {
+	if dateParts.StartDate == "" && dateParts.EndDate == "" {
+		return ""
+	}
+
+	if dateParts.StartDate == dateParts.EndDate {
+		return dateParts.StartDate
+	}
+
+	start, end := dateParts.StartDate, dateParts.EndDate
+	if start == "" {
+		start = "*"
+	}
+	if end == "" {
+		end = "*"
+	}
+
+	return fmt.Sprintf("[%s TO %s]", start, end)
+}
+
+// MakeDateRanges returns consecutive buckets of `interval` years, the first
+// starting at `start` and the last ending at or after `end`, e.g.
+// 1101-1200, 1201-1300, ...  Returns no buckets if `interval` isn't positive.
+func MakeDateRanges(start int, end int, interval int) []DateRange {
+	dateRanges := []DateRange{}
+	if interval <= 0 {
+		return dateRanges
+	}
+
+	for bucketStart := start; bucketStart <= end; bucketStart += interval {
+		bucketEnd := bucketStart + interval - 1
+		dateRanges = append(dateRanges, DateRange{
+			Display:   fmt.Sprintf("%d-%d", bucketStart, bucketEnd),
+			StartDate: bucketStart,
+			EndDate:   bucketEnd,
+		})
+	}
+
+	return dateRanges
+}
+
+// MakeDigitalObjectJSON returns the JSON for `digitalObject` stored in the
+// digital objects Solr field.  Unlike `json.Marshal()`, "&", "<" and ">" in
+// URLs are left unescaped.
+func MakeDigitalObjectJSON(digitalObject DigitalObject) (string, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(digitalObject)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// MakeIntegerYears returns `years` as Solr integer field values, i.e. without
+// leading zeros.  Values that aren't integers are dropped.
+func MakeIntegerYears(years []string) []string {
+	integerYears := []string{}
+	for _, year := range years {
+		integerYear, err := strconv.Atoi(year)
+		if err != nil {
+			continue
+		}
+
+		integerYears = append(integerYears, strconv.Itoa(integerYear))
+	}
+
+	return integerYears
+}
+
+// MakeNoteHTML applies the `MakeTitleHTML()` treatment to the XML strings of
+// note paragraphs, e.g. <arrangement>/<p>.
+func MakeNoteHTML(xmlStrings []string) ([]string, error) {
+	noteHTMLValues := []string{}
+	for _, xmlString := range xmlStrings {
+		noteHTMLValue, err := MakeTitleHTML(StripOpenAndCloseTags(xmlString))
+		if err != nil {
+			return noteHTMLValues, err
+		}
+
+		noteHTMLValues = append(noteHTMLValues, noteHTMLValue)
+	}
+
+	return noteHTMLValues, nil
+}
+
 func MakeTitleHTML(unitTitle string) (string, error) {
 	converted, err := ConvertEADToHTML(unitTitle)
 	if err != nil {
@@ -502,6 +1168,39 @@ func StripTags(xmlString string, allowedTags *[]string) (string, error) {
 	return strippedString, nil
 }
 
+// ValidateDateRangeFacets checks that each facet has a unique field name and at
+// least one valid bucket.
+func ValidateDateRangeFacets(facets []DateRangeFacet) error {
+	if len(facets) == 0 {
+		return errors.New("no date range facets")
+	}
+
+	fieldNames := map[string]bool{}
+	for _, facet := range facets {
+		if facet.FieldName == "" {
+			return errors.New("date range facet has no field name")
+		}
+
+		if fieldNames[facet.FieldName] {
+			return fmt.Errorf(`more than one date range facet for field "%s"`, facet.FieldName)
+		}
+		fieldNames[facet.FieldName] = true
+
+		if len(facet.DateRanges) == 0 {
+			return fmt.Errorf(`date range facet "%s" has no ranges`, facet.FieldName)
+		}
+
+		for _, dateRange := range facet.DateRanges {
+			if dateRange.Display == "" || dateRange.EndDate < dateRange.StartDate {
+				return fmt.Errorf(`date range facet "%s" has invalid range %+v`,
+					facet.FieldName, dateRange)
+			}
+		}
+	}
+
+	return nil
+}
+
 func convertEADTagsWithRenderAttributesToHTML(eadString string) (string, error) {
 	var htmlString string
 
@@ -571,12 +1270,21 @@ func convertEADTagsWithRenderAttributesToHTML(eadString string) (string, error)
 func isDateInRange(dateString string, dateRange DateRange) bool {
 	dateParts := GetDateParts(dateString)
 
-	startDateInt, err := strconv.Atoi(dateParts.Start)
+	// For open ranges, use the one year we have for both ends.
+	start, end := dateParts.Start, dateParts.End
+	if start == "" {
+		start = end
+	}
+	if end == "" {
+		end = start
+	}
+
+	startDateInt, err := strconv.Atoi(start)
 	if err != nil {
 		return false
 	}
 
-	endDateInt, err := strconv.Atoi(dateParts.End)
+	endDateInt, err := strconv.Atoi(end)
 	if err != nil {
 		return false
 	}
@@ -585,6 +1293,152 @@ func isDateInRange(dateString string, dateRange DateRange) bool {
 		(endDateInt >= dateRange.StartDate && endDateInt <= dateRange.EndDate)
 }
 
+// getEAD3Dates returns the display and @normal style values of the
+// <datesingle> and <daterange> elements in a <unitdatestructured>, including
+// those in a <dateset>.
+func getEAD3Dates(unitDateStructuredNode types.Node) ([]ead3Date, error) {
+	ead3Dates := []ead3Date{}
+
+	dateNodes, err := GetNodeList("./datesingle | ./daterange | ./dateset/datesingle | ./dateset/daterange",
+		unitDateStructuredNode)
+	if err != nil {
+		return ead3Dates, err
+	}
+
+	for _, dateNode := range dateNodes {
+		if dateNode.NodeName() == "datesingle" {
+			display, standardDate, err := getEAD3StandardDate(".", dateNode)
+			if err != nil {
+				return ead3Dates, err
+			}
+			ead3Dates = append(ead3Dates, ead3Date{display: display, normal: standardDate})
+			continue
+		}
+
+		fromDisplay, fromStandardDate, err := getEAD3StandardDate("./fromdate", dateNode)
+		if err != nil {
+			return ead3Dates, err
+		}
+		toDisplay, toStandardDate, err := getEAD3StandardDate("./todate", dateNode)
+		if err != nil {
+			return ead3Dates, err
+		}
+
+		date := ead3Date{display: fromDisplay + "-" + toDisplay}
+		if fromStandardDate != "" || toStandardDate != "" {
+			if fromStandardDate == "" {
+				fromStandardDate = ".."
+			}
+			if toStandardDate == "" {
+				toStandardDate = ".."
+			}
+			date.normal = fromStandardDate + "/" + toStandardDate
+		}
+		ead3Dates = append(ead3Dates, date)
+	}
+
+	return ead3Dates, nil
+}
+
+// getEAD3PhysDescStructuredValue renders a <physdescstructured> the way the
+// same extent is written in an EAD 2002 <extent>: "[quantity] [unittype]".
+func getEAD3PhysDescStructuredValue(physDescStructuredNode types.Node) (string, error) {
+	valueParts := []string{}
+	for _, query := range []string{"./quantity", "./unittype"} {
+		values, _, err := GetNodeValuesAndXMLStrings(query, physDescStructuredNode)
+		if err != nil {
+			return "", err
+		}
+		valueParts = append(valueParts, values...)
+	}
+
+	return strings.Join(util.CompactStringSlicePreserveOrder(valueParts), " "), nil
+}
+
+// getEAD3StandardDate returns the text and the @standarddate of the first
+// element matched by `query`.  The @standarddate is used for the text if the
+// element is empty.
+func getEAD3StandardDate(query string, node types.Node) (string, string, error) {
+	dateNode, err := GetFirstNode(query, node)
+	if err != nil || dateNode == nil {
+		return "", "", err
+	}
+
+	standardDate := ""
+	standardDateAttributeNode, err := dateNode.(types.Element).GetAttribute("standarddate")
+	if err == nil {
+		standardDate = strings.TrimSpace(standardDateAttributeNode.Value())
+	}
+
+	display := strings.TrimSpace(dateNode.TextContent())
+	if display == "" {
+		display = standardDate
+	}
+
+	return display, standardDate, nil
+}
+
+// isOpenRangeEnd returns true for the EDTF open ("..") and unknown ("") ends
+// of a date range.
+func isOpenRangeEnd(dateString string) bool {
+	return dateString == "" || dateString == ".."
+}
+
+// parseISODate returns the earliest and latest dates that `dateString` can
+// stand for, in ISO 8601 extended format.  These differ only for years with
+// unspecified digits.
+func parseISODate(dateString string) (string, string, bool) {
+	dateString = edtfQualifierRegexp.ReplaceAllString(strings.TrimSpace(dateString), "")
+
+	if isoDateUnspecifiedYearRegexp.MatchString(dateString) {
+		earliest := strings.NewReplacer("X", "0", "x", "0", "u", "0").Replace(dateString)
+		latest := strings.NewReplacer("X", "9", "x", "9", "u", "9").Replace(dateString)
+
+		return earliest, latest, true
+	}
+
+	matches := isoDateExtendedRegexp.FindStringSubmatch(dateString)
+	if matches == nil {
+		matches = isoDateBasicRegexp.FindStringSubmatch(dateString)
+	}
+	if matches == nil {
+		return "", "", false
+	}
+
+	year, month, day := matches[1], matches[2], matches[3]
+
+	date := year
+	layout := "2006"
+	if month != "" {
+		date += "-" + month
+		layout += "-01"
+	}
+	if day != "" {
+		date += "-" + day
+		layout += "-02"
+	}
+
+	// Validates month and day, including days in month and leap years.
+	if _, err := time.Parse(layout, date); err != nil {
+		return "", "", false
+	}
+
+	return date, date, true
+}
+
+// normalizeEAD3ActuateValue maps the EAD3 values of @actuate that differ
+// from the EAD 2002 xlink values to the EAD 2002 values.
+func normalizeEAD3ActuateValue(actuate string) string {
+	switch actuate {
+	case "actuatenone":
+		return "none"
+	case "actuateother":
+		return "other"
+	default:
+		return actuate
+	}
+}
+
 func parseNodeValue(xmlString string) (string, error) {
 	// We can't just strip <lb/> tags because many times the text on either side
 	// of the tags have no intervening whitespace, and so simple removal would
@@ -25,6 +25,8 @@ type docElement struct {
 	FieldA []string `xml:"field_a"`
 	FieldC []string `xml:"field_c"`
 	FieldD string   `xml:"field_d"`
+
+	FieldsBF map[string][]string `xml:"-"`
 }
 
 // Assign values intentionally in non-alphabetic order and also not in the
@@ -35,6 +37,10 @@ var testDocElement = docElement{
 	FieldC: []string{"C1", "C2"},
 	FieldB: "B1",
 	FieldA: []string{"A1"},
+	FieldsBF: map[string][]string{
+		"field_f":  {"F1"},
+		"field_bb": {"BB1", "BB2"},
+	},
 }
 
 var fixturesDirPath string
@@ -267,10 +273,182 @@ func TestGetDateParts(t *testing.T) {
 		{
 			"Gets start and end date for valid date string",
 			"2016/2020",
-			DateParts{
-				Start: "2016",
-				End:   "2020",
-			},
+			DateParts{Start: "2016", End: "2020", StartDate: "2016", EndDate: "2020"},
+		},
+		{
+			"Gets start and end date for same start and end year",
+			"2016/2016",
+			DateParts{Start: "2016", End: "2016", StartDate: "2016", EndDate: "2016"},
+		},
+		{
+			"Gets start and end date with allowable leading and trailing whitespace",
+			" 2016/2020\t\r\n",
+			DateParts{Start: "2016", End: "2020", StartDate: "2016", EndDate: "2020"},
+		},
+		{
+			"Gets start and end date for single year",
+			"1950",
+			DateParts{Start: "1950", End: "1950", StartDate: "1950", EndDate: "1950"},
+		},
+		{
+			"Gets start and end date for single year and month",
+			"1950-03",
+			DateParts{Start: "1950", End: "1950", StartDate: "1950-03", EndDate: "1950-03"},
+		},
+		{
+			"Gets start and end date for single full date",
+			"1950-03-01",
+			DateParts{Start: "1950", End: "1950", StartDate: "1950-03-01", EndDate: "1950-03-01"},
+		},
+		{
+			"Gets start and end date for YYYY-MM-DD range",
+			"1950-03-01/1951-12-31",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950-03-01", EndDate: "1951-12-31"},
+		},
+		{
+			"Gets start and end date for YYYY-MM range",
+			"1950-03/1951-12",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950-03", EndDate: "1951-12"},
+		},
+		{
+			"Gets start and end date for range of mixed precision",
+			"1950-03-01/1951-12",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950-03-01", EndDate: "1951-12"},
+		},
+		{
+			"Gets start and end date for range of YYYY and YYYY-MM-DD",
+			"1950/1951-12-31",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950", EndDate: "1951-12-31"},
+		},
+		{
+			"Gets start and end date for ISO 8601 basic format YYYYMMDD",
+			"19500301/19511231",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950-03-01", EndDate: "1951-12-31"},
+		},
+		{
+			"Gets start and end date for range within a year of mixed precision",
+			"1950-03/1950",
+			DateParts{Start: "1950", End: "1950", StartDate: "1950-03", EndDate: "1950"},
+		},
+		{
+			"Gets start date only for open range",
+			"1950/..",
+			DateParts{Start: "1950", StartDate: "1950"},
+		},
+		{
+			"Gets end date only for open range",
+			"../1950-06",
+			DateParts{End: "1950", EndDate: "1950-06"},
+		},
+		{
+			"Gets start date only for range with unknown end",
+			"1950/",
+			DateParts{Start: "1950", StartDate: "1950"},
+		},
+		{
+			"Gets end date only for range with unknown start",
+			"/1950",
+			DateParts{End: "1950", EndDate: "1950"},
+		},
+		{
+			"Gets decade for single year with one unspecified digit",
+			"195X",
+			DateParts{Start: "1950", End: "1959", StartDate: "1950", EndDate: "1959"},
+		},
+		{
+			"Gets decade for single year with lowercase unspecified digit",
+			"195x",
+			DateParts{Start: "1950", End: "1959", StartDate: "1950", EndDate: "1959"},
+		},
+		{
+			`Gets decade for single year with old-style "u" unspecified digit`,
+			"195u",
+			DateParts{Start: "1950", End: "1959", StartDate: "1950", EndDate: "1959"},
+		},
+		{
+			"Gets century for single year with two unspecified digits",
+			"19XX",
+			DateParts{Start: "1900", End: "1999", StartDate: "1900", EndDate: "1999"},
+		},
+		{
+			"Gets earliest start and latest end for range with unspecified digits",
+			"195X/196X",
+			DateParts{Start: "1950", End: "1969", StartDate: "1950", EndDate: "1969"},
+		},
+		{
+			"Ignores EDTF uncertain qualifier",
+			"1950?",
+			DateParts{Start: "1950", End: "1950", StartDate: "1950", EndDate: "1950"},
+		},
+		{
+			"Ignores EDTF approximate qualifier",
+			"1950-03~/1951",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950-03", EndDate: "1951"},
+		},
+		{
+			"Ignores EDTF uncertain and approximate qualifier",
+			"1950%/1951?",
+			DateParts{Start: "1950", End: "1951", StartDate: "1950", EndDate: "1951"},
+		},
+		{
+			"Accepts leap day in leap year",
+			"2000-02-29",
+			DateParts{Start: "2000", End: "2000", StartDate: "2000-02-29", EndDate: "2000-02-29"},
+		},
+		{
+			"Returns empty `DateParts` for leap day in non-leap year",
+			"1900-02-29",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for invalid month",
+			"1950-13/1951",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for invalid day",
+			"1950-04-31",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for unspecified digit in year with month",
+			"195X-03",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for unspecified digit in first two digits of year",
+			"1X50",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for end before start",
+			"2020/2016",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for end month before start month in the same year",
+			"1950-12/1950-03",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for end day before start day in the same month",
+			"1950-03-15/1950-03-01",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for ISO 8601 basic format YYYYMM",
+			"195003",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for range with both ends open",
+			"../..",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for one invalid end of range",
+			"1950/BAD",
+			DateParts{},
 		},
 		{
 			"Returns empty `DateParts` for ambiguous date string",
@@ -282,6 +460,21 @@ func TestGetDateParts(t *testing.T) {
 			"2016-2020",
 			DateParts{},
 		},
+		{
+			"Returns empty `DateParts` for two-digit year",
+			"50",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for five-digit year",
+			"19500",
+			DateParts{},
+		},
+		{
+			"Returns empty `DateParts` for ArchivesSpace invalid date placeholder",
+			"[INVALID DATE FORMAT]",
+			DateParts{},
+		},
 		{
 			"Returns empty `DateParts` for invalid date string",
 			"BAD DATES, INDY!",
@@ -296,10 +489,9 @@ func TestGetDateParts(t *testing.T) {
 
 	for _, testCase := range testCases {
 		actual := GetDateParts(testCase.dateString)
-		if actual.Start != testCase.expectedDateParts.Start || actual.End != testCase.expectedDateParts.End {
-			t.Errorf(`%s: expected start="%s" and end="%s" for date string="%s", but got start="%s" and end="%s"`,
-				testCase.name, testCase.expectedDateParts.Start, testCase.expectedDateParts.End,
-				testCase.dateString, actual.Start, actual.End)
+		if actual != testCase.expectedDateParts {
+			t.Errorf(`%s: expected %+v for date string="%s", but got %+v`,
+				testCase.name, testCase.expectedDateParts, testCase.dateString, actual)
 		}
 	}
 }
@@ -329,6 +521,21 @@ func TestGetDateRange(t *testing.T) {
 				"2001-2100",
 			},
 		},
+		{
+			"Maps single dates, full dates, open ranges and unspecified digits",
+			[]string{
+				"1950",
+				"1250-03-01/1251-12",
+				"2016/..",
+				"185X",
+			},
+			[]string{
+				"1201-1300",
+				"1801-1900",
+				"1901-2000",
+				"2001-2100",
+			},
+		},
 		{
 			"Returns undated for one mappable date and one syntactically valid but unmappable date",
 			[]string{
@@ -359,7 +566,7 @@ func TestGetDateRange(t *testing.T) {
 	}
 
 	for _, testCase := range testCases {
-		actual := GetDateRange(testCase.unitDates)
+		actual := GetDateRange(testCase.unitDates, DefaultDateRangeFacets()[0].DateRanges)
 		if slices.Compare(actual, testCase.expectedDateRange) != 0 {
 			t.Errorf(`%s: expected dates "%v" to map to ranges %v, but got ranges %v`,
 				testCase.name, testCase.unitDates, testCase.expectedDateRange, actual)
@@ -367,6 +574,288 @@ func TestGetDateRange(t *testing.T) {
 	}
 }
 
+func TestGetDateRangeFacetValues(t *testing.T) {
+	unitDates := []string{"0950/1050", "1955-06-01/1961"}
+
+	actual := GetDateRangeFacetValues(unitDates, DefaultDateRangeFacets())
+	expected := map[string][]string{
+		DefaultDateRangeFacetFieldName: {undated},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected default date range facet values %v, got %v", expected, actual)
+	}
+
+	dateRangeFacets, err := LoadDateRangeFacets(filepath.Join(fixturesDirPath, "date-range-facets.json"))
+	if err != nil {
+		t.Fatalf("LoadDateRangeFacets() failed with error: %s", err)
+	}
+
+	actual = GetDateRangeFacetValues(unitDates, dateRangeFacets)
+	expected = map[string][]string{
+		"date_range_sim": {"901-1000", "1001-1100", "1901-2000"},
+		"decade_sim":     {"Before 1900", "1950-1959", "1960-1969"},
+		"era_sim":        {"Medieval", "Modern"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected configured date range facet values %v, got %v", expected, actual)
+	}
+}
+
+func TestGetDigitalObjects(t *testing.T) {
+	daoXMLStrings := []string{
+		`<dao xlink:actuate="onRequest" xlink:href="https://hdl.handle.net/2333.1/34tmpk87" xlink:role="video-service" xlink:show="new" xlink:title="This is a digital object" xlink:type="simple"><daodesc><p>This is a digital object</p></daodesc></dao>`,
+		`<daoloc xlink:href="https://wayback.archive-it.org/9900/*/http://www.example.com?a=1&amp;b=2" xlink:role="external-link" xlink:title="Archived website" xlink:type="locator"/>`,
+		`<dao xlink:title="No link" xlink:type="simple"/>`,
+		`<dao actuate="actuatenone" daotype="unknown" href="https://hdl.handle.net/2333.1/material-request-placeholder" linkrole="electronic-records-reading-room" linktitle="EAD3 digital object" show="new"/>`,
+	}
+	expected := []DigitalObject{
+		{
+			Actuate: "onRequest",
+			Href:    "https://hdl.handle.net/2333.1/34tmpk87",
+			Role:    "video-service",
+			Title:   "This is a digital object",
+		},
+		{
+			Href:  "https://wayback.archive-it.org/9900/*/http://www.example.com?a=1&b=2",
+			Role:  "external-link",
+			Title: "Archived website",
+		},
+		{
+			Title: "No link",
+		},
+		{
+			Actuate: "none",
+			Href:    "https://hdl.handle.net/2333.1/material-request-placeholder",
+			Role:    "electronic-records-reading-room",
+			Title:   "EAD3 digital object",
+		},
+	}
+
+	actual, err := GetDigitalObjects(daoXMLStrings)
+	if err != nil {
+		t.Errorf(`Expected no error, but got error: "%s"`, err)
+	}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf("Expected GetDigitalObjects() to return %v, got %v", expected, actual)
+	}
+
+	expectedJSON := `{"href":"https://wayback.archive-it.org/9900/*/http://www.example.com?a=1&b=2","role":"external-link","title":"Archived website"}`
+	actualJSON, err := MakeDigitalObjectJSON(actual[1])
+	if err != nil {
+		t.Errorf(`Expected no error, but got error: "%s"`, err)
+	}
+
+	if actualJSON != expectedJSON {
+		t.Errorf(`Expected MakeDigitalObjectJSON() to return %s, got %s`, expectedJSON, actualJSON)
+	}
+}
+
+func TestGetEAD3NodeValuesAndXMLStrings(t *testing.T) {
+	const didXML = `<did>
+  <unitdatestructured unitdatetype="inclusive">
+    <daterange><fromdate standarddate="1977">1977</fromdate><todate standarddate="1980">1980</todate></daterange>
+  </unitdatestructured>
+  <unitdatestructured unitdatetype="bulk"><datesingle standarddate="1978-05">May 1978</datesingle></unitdatestructured>
+  <unitdatestructured>
+    <dateset>
+      <datesingle standarddate="1950"/>
+      <daterange><fromdate standarddate="1960-01-01">January 1, 1960</fromdate><todate/></daterange>
+      <datesingle>undated</datesingle>
+    </dateset>
+  </unitdatestructured>
+  <unitdate normal="1990/1999">1990s</unitdate>
+  <physdescstructured coverage="whole" physdescstructuredtype="spaceoccupied">
+    <quantity>2.5</quantity>
+    <unittype>linear feet</unittype>
+  </physdescstructured>
+  <physdesc>in 3 boxes</physdesc>
+</did>`
+
+	xmlDoc, err := parser.New().ParseString(didXML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer xmlDoc.Free()
+
+	rootNode, err := xmlDoc.DocumentElement()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		query          string
+		expectedValues []string
+	}{
+		{"./unitdatestructured[@unitdatetype='inclusive']", []string{"1977-1980"}},
+		{"./unitdatestructured[@unitdatetype='bulk']", []string{"May 1978"}},
+		{"./unitdatestructured[not(@unitdatetype)]", []string{"1950, January 1, 1960-, undated"}},
+		{"./unitdate", []string{"1990s"}},
+		{"./physdescstructured | ./physdesc", []string{"2.5 linear feet", "in 3 boxes"}},
+	}
+	for _, testCase := range testCases {
+		actualValues, actualXMLStrings, err := GetEAD3NodeValuesAndXMLStrings(testCase.query, rootNode)
+		if err != nil {
+			t.Errorf(`GetEAD3NodeValuesAndXMLStrings("%s") returned error: %s`, testCase.query, err)
+			continue
+		}
+
+		if !slices.Equal(actualValues, testCase.expectedValues) {
+			t.Errorf(`Expected GetEAD3NodeValuesAndXMLStrings("%s") to return values %#v, got %#v`,
+				testCase.query, testCase.expectedValues, actualValues)
+		}
+		if len(actualXMLStrings) != len(actualValues) {
+			t.Errorf(`Expected GetEAD3NodeValuesAndXMLStrings("%s") to return an XML string for each value, got %#v`,
+				testCase.query, actualXMLStrings)
+		}
+	}
+
+	const normalQuery = "./unitdate/@normal | ./unitdatestructured"
+	expectedNormalValues := []string{"1977/1980", "1978-05", "1950", "1960-01-01/..", "1990/1999"}
+	actualNormalValues, _, err := GetEAD3UnitDateNormalValuesAndXMLStrings(normalQuery, rootNode)
+	if err != nil {
+		t.Fatalf(`GetEAD3UnitDateNormalValuesAndXMLStrings("%s") returned error: %s`, normalQuery, err)
+	}
+	if !slices.Equal(actualNormalValues, expectedNormalValues) {
+		t.Errorf(`Expected GetEAD3UnitDateNormalValuesAndXMLStrings("%s") to return values %#v, got %#v`,
+			normalQuery, expectedNormalValues, actualNormalValues)
+	}
+}
+
+func TestGetEADVersion(t *testing.T) {
+	testCases := []struct {
+		eadXML   string
+		expected EADVersion
+	}{
+		{`<ead><eadheader><eadid>mss_580</eadid></eadheader><archdesc level="collection"/></ead>`, EADVersion2002},
+		{`<ead><control><recordid>mss_580</recordid></control><archdesc level="collection"/></ead>`, EADVersion3},
+	}
+	for _, testCase := range testCases {
+		xmlDoc, err := parser.New().ParseString(testCase.eadXML)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rootNode, err := xmlDoc.DocumentElement()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual, err := GetEADVersion(rootNode)
+		xmlDoc.Free()
+		if err != nil {
+			t.Errorf(`GetEADVersion() returned error for %s: %s`, testCase.eadXML, err)
+			continue
+		}
+
+		if actual != testCase.expected {
+			t.Errorf(`Expected GetEADVersion() to return "%s" for %s, got "%s"`,
+				testCase.expected, testCase.eadXML, actual)
+		}
+	}
+}
+
+func TestGetExtentQuantities(t *testing.T) {
+	testCases := []struct {
+		extents  []string
+		expected []ExtentQuantity
+	}{
+		{[]string{}, []ExtentQuantity{}},
+		{[]string{"1059 Photographic Prints"}, []ExtentQuantity{}},
+		{[]string{"in one folder"}, []ExtentQuantity{}},
+		{[]string{"2.5 Linear Feet"}, []ExtentQuantity{{"2.5", ExtentUnitLinearFeet}}},
+		{[]string{"1 linear foot"}, []ExtentQuantity{{"1", ExtentUnitLinearFeet}}},
+		{[]string{"1,200 lin. ft."}, []ExtentQuantity{{"1200", ExtentUnitLinearFeet}}},
+		{[]string{"(2 boxes)"}, []ExtentQuantity{{"2", ExtentUnitBoxes}}},
+		{[]string{"in 3 manuscript boxes."}, []ExtentQuantity{{"3", ExtentUnitBoxes}}},
+		{[]string{"in one half manuscript box"}, []ExtentQuantity{{"0.5", ExtentUnitBoxes}}},
+		{[]string{"3 Half boxes"}, []ExtentQuantity{{"1.5", ExtentUnitBoxes}}},
+		{[]string{"for 1 item"}, []ExtentQuantity{{"1", ExtentUnitItems}}},
+		{[]string{"74 Gigabytes"}, []ExtentQuantity{{"74", ExtentUnitGB}}},
+		{[]string{"350  Megabytes"}, []ExtentQuantity{{"0.35", ExtentUnitGB}}},
+		{[]string{"2 TB"}, []ExtentQuantity{{"2000", ExtentUnitGB}}},
+		{[]string{"2 linear feet (4 boxes)"},
+			[]ExtentQuantity{{"2", ExtentUnitLinearFeet}, {"4", ExtentUnitBoxes}}},
+		{[]string{"in 20 manuscript boxes and 1 small flat box."}, []ExtentQuantity{{"21", ExtentUnitBoxes}}},
+		{[]string{"2 folders containing items"}, []ExtentQuantity{}},
+		{[]string{"1 folder of 3 photographs in 2 shared boxes"}, []ExtentQuantity{}},
+		{[]string{"in 75 boxes: 32 manuscript boxes, 38 flat boxes, and 6 small boxes"},
+			[]ExtentQuantity{{"75", ExtentUnitBoxes}}},
+		{[]string{"in 13 boxes (12 record cartons, 1 flat box) and 5 oversize folders"},
+			[]ExtentQuantity{{"13", ExtentUnitBoxes}}},
+		{[]string{"Total: 20 items\n5 items were gifted and 15 items were loaned."},
+			[]ExtentQuantity{{"20", ExtentUnitItems}}},
+		{
+			[]string{"0.1 Linear Feet", "0.2 Linear Feet", "(1 box)", "500 kilobytes", "1 Gigabytes"},
+			[]ExtentQuantity{
+				{"0.3", ExtentUnitLinearFeet},
+				{"1", ExtentUnitBoxes},
+				{"1.0005", ExtentUnitGB},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		actual := GetExtentQuantities(testCase.extents)
+		if !slices.Equal(actual, testCase.expected) {
+			t.Errorf(`Expected GetExtentQuantities(%q) to return %v, got %v`,
+				testCase.extents, testCase.expected, actual)
+		}
+	}
+}
+
+func TestGetRestricted(t *testing.T) {
+	testCases := []struct {
+		accessRestrict []string
+		expected       string
+	}{
+		{[]string{}, ""},
+		{[]string{"Currently available."}, Unrestricted},
+		{[]string{"This collection is open for research use without restriction."}, Unrestricted},
+		{[]string{"There are no access restrictions on this collection."}, Unrestricted},
+		{[]string{"Materials in the collection are unrestricted."}, Unrestricted},
+		{[]string{"Access to this collection is not restricted."}, Unrestricted},
+		{[]string{"Open for research without any restrictions."}, Unrestricted},
+		{[]string{"There are no known restrictions on access."}, Unrestricted},
+		{[]string{"The collection is not subject to restrictions."}, Unrestricted},
+		{[]string{"Researchers will be allowed access to the unrestricted materials in the collection."}, Unrestricted},
+		{[]string{"Currently unavailable until duplication occurs."}, Restricted},
+		{[]string{"Due to the fragile condition of this material, access may be supervised or restricted."}, Restricted},
+		{[]string{"Born-digital materials have not been preserved and may not be available to researchers."}, Restricted},
+		{[]string{"Series III is closed until 2050."}, Restricted},
+		{[]string{"Open for research.", "Correspondence is sealed."}, Restricted},
+		{[]string{"No restrictions apply to Series I; Series II is restricted."}, Restricted},
+	}
+
+	for _, testCase := range testCases {
+		actual := GetRestricted(testCase.accessRestrict)
+		if actual != testCase.expected {
+			t.Errorf(`Expected GetRestricted(%q) to return "%s", got "%s"`,
+				testCase.accessRestrict, testCase.expected, actual)
+		}
+	}
+}
+
+func TestGetScript(t *testing.T) {
+	testCases := []struct {
+		scriptCodes []string
+		expected    []string
+	}{
+		{[]string{}, []string{}},
+		{[]string{"Latn", "Arab"}, []string{"Latin", "Arabic"}},
+		{[]string{"Qaaa"}, []string{"Qaaa"}},
+		{[]string{"", "Grek"}, []string{"Greek"}},
+	}
+
+	for _, testCase := range testCases {
+		actual := GetScript(testCase.scriptCodes)
+		if !slices.Equal(actual, testCase.expected) {
+			t.Errorf(`Expected GetScript(%q) to return %q, got %q`,
+				testCase.scriptCodes, testCase.expected, actual)
+		}
+	}
+}
+
 func TestGetUnitDateDisplay(t *testing.T) {
 	testCases := []struct {
 		name                    string
@@ -496,11 +985,35 @@ func TestIsDateInRange(t *testing.T) {
 			false,
 		},
 		{
-			"Returns false for single year instead of two years",
+			"Returns true for single year in range",
 			"2016",
 			DateRange{Display: "2001-2100", StartDate: 2001, EndDate: 2100},
+			true,
+		},
+		{
+			"Returns false for single year out of range",
+			"1999",
+			DateRange{Display: "2001-2100", StartDate: 2001, EndDate: 2100},
 			false,
 		},
+		{
+			"Returns true for open range with start date in range",
+			"2016/..",
+			DateRange{Display: "2001-2100", StartDate: 2001, EndDate: 2100},
+			true,
+		},
+		{
+			"Returns true for range with unknown start and end date in range",
+			"/2016",
+			DateRange{Display: "2001-2100", StartDate: 2001, EndDate: 2100},
+			true,
+		},
+		{
+			"Returns true for YYYY-MM-DD range in range",
+			"2016-01-01/2020-12-31",
+			DateRange{Display: "2001-2100", StartDate: 2001, EndDate: 2100},
+			true,
+		},
 		{
 			`Returns false for YYYY-MM-DD formats and " to " instead of slash`,
 			"2016-01-01 to 2020-12-31",
@@ -563,24 +1076,35 @@ func TestIsValidEADID(t *testing.T) {
 func TestLanguage(t *testing.T) {
 	testCases := []struct {
 		name              string
+		mode              languageLib.Mode
 		langCodes         []string
 		expectedLanguages []string
 		expectedErrors    []error
 	}{
 		{
 			`Simple lookup`,
+			"",
 			[]string{"ara", "eng"},
 			[]string{"Arabic", "English"},
 			[]error{},
 		},
+		{
+			`Modern lookup`,
+			languageLib.ModeModern,
+			[]string{"scc", "en-US"},
+			[]string{"Serbian", "English"},
+			[]error{},
+		},
 		{
 			`Language code not found`,
+			"",
 			[]string{"xxx"},
 			[]string{},
 			[]error{languageLib.ErrLanguageNotFound},
 		},
 		{
 			`Invalid language codes`,
+			"",
 			[]string{
 				"",
 				"!!!",
@@ -600,7 +1124,7 @@ func TestLanguage(t *testing.T) {
 	}
 
 	for _, testCase := range testCases {
-		actualLanguages, actualErrors := GetLanguage(testCase.langCodes)
+		actualLanguages, actualErrors := GetLanguage(testCase.langCodes, testCase.mode)
 		if slices.Compare(actualLanguages, testCase.expectedLanguages) != 0 {
 			t.Errorf(`%s: expected language codes "%v" to map to languages %v, but got %v`,
 				testCase.name, testCase.langCodes, testCase.expectedLanguages,
@@ -627,19 +1151,165 @@ func TestLanguage(t *testing.T) {
 	}
 }
 
+func TestLoadDateRangeFacets(t *testing.T) {
+	dateRangeFacets, err := LoadDateRangeFacets(filepath.Join(fixturesDirPath, "date-range-facets.json"))
+	if err != nil {
+		t.Fatalf("LoadDateRangeFacets() failed with error: %s", err)
+	}
+
+	actualFieldNames := []string{}
+	actualNumDateRanges := []int{}
+	for _, dateRangeFacet := range dateRangeFacets {
+		actualFieldNames = append(actualFieldNames, dateRangeFacet.FieldName)
+		actualNumDateRanges = append(actualNumDateRanges, len(dateRangeFacet.DateRanges))
+	}
+
+	expectedFieldNames := []string{"date_range_sim", "decade_sim", "era_sim"}
+	if !slices.Equal(actualFieldNames, expectedFieldNames) {
+		t.Errorf("Expected field names %v, got %v", expectedFieldNames, actualFieldNames)
+	}
+
+	// 21 centuries; "Before 1900" plus 13 decades; 3 eras
+	expectedNumDateRanges := []int{21, 14, 3}
+	if !slices.Equal(actualNumDateRanges, expectedNumDateRanges) {
+		t.Errorf("Expected numbers of ranges %v, got %v", expectedNumDateRanges, actualNumDateRanges)
+	}
+
+	expectedFirstDecade := DateRange{Display: "1900-1909", StartDate: 1900, EndDate: 1909}
+	if dateRangeFacets[1].DateRanges[1] != expectedFirstDecade {
+		t.Errorf("Expected first generated decade %+v, got %+v",
+			expectedFirstDecade, dateRangeFacets[1].DateRanges[1])
+	}
+
+	testCases := []struct {
+		name          string
+		config        string
+		expectedError string
+	}{
+		{
+			"Invalid JSON",
+			`[{"field": "date_range_sim",]`,
+			"couldn't parse date range facets configuration",
+		},
+		{
+			"No facets",
+			`[]`,
+			"no date range facets",
+		},
+		{
+			"No field name",
+			`[{"interval": 100, "start": 1101, "end": 2100}]`,
+			"date range facet has no field name",
+		},
+		{
+			"Duplicate field name",
+			`[{"field": "date_range_sim", "interval": 100, "start": 1101, "end": 2100},
+			  {"field": "date_range_sim", "interval": 10, "start": 1900, "end": 2029}]`,
+			`more than one date range facet for field "date_range_sim"`,
+		},
+		{
+			"No ranges",
+			`[{"field": "date_range_sim"}]`,
+			`date range facet "date_range_sim" has no ranges`,
+		},
+		{
+			"End before start",
+			`[{"field": "date_range_sim", "interval": 100, "start": 2100, "end": 1101}]`,
+			`date range facet "date_range_sim": end 1101 is before start 2100`,
+		},
+		{
+			"Negative interval",
+			`[{"field": "date_range_sim", "interval": -100, "start": 1101, "end": 2100}]`,
+			`date range facet "date_range_sim": interval must not be negative`,
+		},
+		{
+			"Range with no display value",
+			`[{"field": "era_sim", "ranges": [{"start": 500, "end": 1499}]}]`,
+			`date range facet "era_sim" has invalid range`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		configPath := filepath.Join(t.TempDir(), "date-range-facets.json")
+		err := os.WriteFile(configPath, []byte(testCase.config), 0644)
+		if err != nil {
+			t.Fatalf("%s: couldn't write configuration file: %s", testCase.name, err)
+		}
+
+		_, err = LoadDateRangeFacets(configPath)
+		if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+			t.Errorf(`%s: expected error containing "%s", got "%v"`,
+				testCase.name, testCase.expectedError, err)
+		}
+	}
+
+	_, err = LoadDateRangeFacets(filepath.Join(t.TempDir(), "nonexistent.json"))
+	if err == nil {
+		t.Errorf("Expected error for nonexistent configuration file, got none")
+	}
+}
+
 // This covers both `MakeSolrAddMessageFieldElementStrings` and `GetDocElementFieldsInAlphabeticalOrder`.
 // Making a test for `GetDocElementFieldsInAlphabeticalOrder` would be more of a
 // pain given the `reflect` stuff that would be needed to unpack the values.
+func TestMakeDateRangeFieldValue(t *testing.T) {
+	testCases := []struct {
+		normal   string
+		expected string
+	}{
+		{"", ""},
+		{"not a date", ""},
+		{"1950/1975", "[1950 TO 1975]"},
+		{"1950-03/1951-12-31", "[1950-03 TO 1951-12-31]"},
+		{"1950", "1950"},
+		{"1950-03-01", "1950-03-01"},
+		{"1950/1950", "1950"},
+		{"1950/..", "[1950 TO *]"},
+		{"/1975", "[* TO 1975]"},
+	}
+
+	for _, testCase := range testCases {
+		actual := MakeDateRangeFieldValue(GetDateParts(testCase.normal))
+		if actual != testCase.expected {
+			t.Errorf(`Expected MakeDateRangeFieldValue(GetDateParts(%q)) to return %q, got %q`,
+				testCase.normal, testCase.expected, actual)
+		}
+	}
+}
+
+func TestMakeIntegerYears(t *testing.T) {
+	testCases := []struct {
+		years    []string
+		expected []string
+	}{
+		{[]string{}, []string{}},
+		{[]string{"1950", "1975"}, []string{"1950", "1975"}},
+		{[]string{"0950", "0001"}, []string{"950", "1"}},
+		{[]string{"19XX", "1975"}, []string{"1975"}},
+	}
+
+	for _, testCase := range testCases {
+		actual := MakeIntegerYears(testCase.years)
+		if !slices.Equal(actual, testCase.expected) {
+			t.Errorf(`Expected MakeIntegerYears(%q) to return %q, got %q`,
+				testCase.years, testCase.expected, actual)
+		}
+	}
+}
+
 func TestMakeSolrAddMessageFieldElementStrings(t *testing.T) {
 	expectedFieldElementStrings := []string{
 		`<field name="field_a">A1</field>`,
 		`<field name="field_b">B1</field>`,
+		`<field name="field_bb">BB1</field>`,
+		`<field name="field_bb">BB2</field>`,
 		`<field name="field_c">C1</field>`,
 		`<field name="field_c">C2</field>`,
 		`<field name="field_d">D1</field>`,
 		`<field name="field_e">E1</field>`,
 		`<field name="field_e">E2</field>`,
 		`<field name="field_e">E3</field>`,
+		`<field name="field_f">F1</field>`,
 	}
 
 	docElementFields := GetDocElementFieldsInAlphabeticalOrder(testDocElement)
@@ -650,6 +1320,89 @@ func TestMakeSolrAddMessageFieldElementStrings(t *testing.T) {
 	}
 }
 
+func TestMakeSolrAddMessageJSON(t *testing.T) {
+	expectedJSON := `{
+  "add": {
+    "doc": {
+      "field_a": [
+        "A1"
+      ],
+      "field_b": "B1",
+      "field_bb": [
+        "BB1",
+        "BB2"
+      ],
+      "field_c": [
+        "C1",
+        "C2"
+      ],
+      "field_d": "D1",
+      "field_e": [
+        "E1",
+        "E2",
+        "E3"
+      ],
+      "field_f": [
+        "F1"
+      ]
+    }
+  }
+}
+`
+
+	docElementFields := GetDocElementFieldsInAlphabeticalOrder(testDocElement)
+	actualJSON := MakeSolrAddMessageJSON(docElementFields)
+
+	if actualJSON != expectedJSON {
+		t.Errorf("Expected %s, got %s", expectedJSON, actualJSON)
+	}
+
+	// Empty values are dropped, and nothing is escaped except what JSON requires.
+	docElementFields = GetDocElementFieldsInAlphabeticalOrder(docElement{
+		FieldA: []string{"", " "},
+		FieldB: `<emph render="italic">Fish</emph> & "Chips"`,
+		FieldD: "   ",
+		FieldE: []string{"&amp;", ""},
+	})
+	expectedJSON = `{
+  "add": {
+    "doc": {
+      "field_b": "<emph render=\"italic\">Fish</emph> & \"Chips\"",
+      "field_e": [
+        "&amp;"
+      ]
+    }
+  }
+}
+`
+	actualJSON = MakeSolrAddMessageJSON(docElementFields)
+
+	if actualJSON != expectedJSON {
+		t.Errorf("Expected %s, got %s", expectedJSON, actualJSON)
+	}
+}
+
+func TestMakeNoteHTML(t *testing.T) {
+	xmlStrings := []string{
+		`<p>Arranged in two series: <emph render="italic">Writings</emph> and Correspondence.</p>`,
+		`<p id="aspace_123">See also the <title render="italic">Papers of Jane Doe</title>.</p>`,
+	}
+	expected := []string{
+		`Arranged in two series: <em>Writings</em> and Correspondence.`,
+		`See also the <em>Papers of Jane Doe</em>.`,
+	}
+
+	actual, err := MakeNoteHTML(xmlStrings)
+	if err != nil {
+		t.Errorf(`Expected no error, but got error: "%s"`, err)
+	}
+
+	if !slices.Equal(actual, expected) {
+		t.Errorf(`Expected MakeNoteHTML(%q) to return %q, got %q`,
+			xmlStrings, expected, actual)
+	}
+}
+
 // `MakeTitleHTML()` just calls `ConvertEADToHTML()` and `StripTags()` in succession.
 // Those function already have their own unit test coverage, so we don't necessarily
 // have to do extensive testing here, which would just have to be mechanically